@@ -182,7 +182,7 @@ func getClusterLifeCycleService(t *testing.T) (*agent.LiveService, *cluster.Live
 		os.Exit(1)
 	}
 	jwtManager := *auth.CreateJwtManager("")
-	svc := agent.NewLiveService(ctx, patchtool.NewClusterConfigInstallTool(clusterStatus, false), jwtManager, reconcilerRegistery)
+	svc := agent.NewLiveService(ctx, patchtool.NewClusterConfigInstallTool(clusterStatus, false), jwtManager, reconcilerRegistery, nil)
 	if kc, err := svc.InstallTool.Config(ctx); err == nil {
 		if svc.ReconcileRegistry.GetReconciler(statusreconciler.ClusterStatusReconcilerName) == nil && kc != nil {
 			clusterStatusReconciler, err := statusreconciler.NewClusterStatusReconciler(ctx, string(kc))
@@ -3,22 +3,47 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"kubeclusteragent/pkg/agent"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/db"
 	flagutil "kubeclusteragent/pkg/util/flag"
 	"kubeclusteragent/pkg/util/log/log"
 	"os"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		if _, ok := stateCommands[os.Args[1]]; ok {
+			if err := runStateCommand(context.Background(), os.Args[1], os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
 	var config agent.Config
 	var timeformat *string
 	flagutil.EnvStringVar(&config.GRPCAddr, "AGENT_GRPC_ADDR", "grpc-addr", "0.0.0.0:50055", "gRPC server address")
 	flagutil.EnvStringVar(&config.ServerAddr, "AGENT_SERVER_ADDR", "server-addr", "0.0.0.0:8080", "HTTP server address")
 	flagutil.EnvBoolVar(&config.DryRun, "AGENT_DRY_RUN", "dry-run", false, "Run in dry run mode")
+	flagutil.EnvStringVar(&config.StateFilePath, "AGENT_STATE_FILE", "state-file", db.DBFilePath, "Path of the cluster state store")
 	flagutil.EnvStringVar(&config.TokenSharedKey, "TOKEN_SHARED_KEY", "secret-key", "", "Secret key for token verification")
+	flagutil.EnvBoolVar(&config.AuthorizeRequests, "AGENT_AUTHORIZE_REQUESTS", "authorize-requests", false, "Require a token of an allowed role on every request, not only on the script and state requests")
 	flagutil.EnvStringVar(&config.ServerCertFilePath, "SERVER_CERT", "server-cert", "", "Server cert for tls")
 	flagutil.EnvStringVar(&config.ServerKeyFilePath, "SERVER_KEY", "server-key", "", "Server key for tls")
 	flagutil.EnvStringVar(&config.CACertFilePath, "CA_CERT", "ca-cert", "", "CA cert for tls")
+	flagutil.EnvBoolVar(&config.RollbackInterruptedOperations, "AGENT_ROLLBACK_INTERRUPTED_OPERATIONS", "rollback-interrupted-operations", false, "Roll back operations interrupted by a restart instead of resuming them")
+	flagutil.EnvStringVar(&config.ScriptDirectory, "AGENT_SCRIPT_DIR", "script-dir", constants.ScriptDirectory, "Directory of the scripts and operation hooks the agent may run, empty disables them")
+	flagutil.EnvIntVar(&config.MaxParallelTasks, "AGENT_MAX_PARALLEL_TASKS", "max-parallel-tasks", constants.DefaultMaxParallelTasks, "Maximum number of independent tasks of an operation run at the same time")
+	flagutil.EnvDurationVar(&config.IdempotencyKeyTTL, "AGENT_IDEMPOTENCY_KEY_TTL", "idempotency-key-ttl", constants.DefaultIdempotencyKeyTTL, "How long the response of a request made with an Idempotency-Key is replayed")
+	flagutil.EnvIntVar(&config.AuditRetentionCount, "AGENT_AUDIT_RETENTION_COUNT", "audit-retention-count", constants.DefaultAuditRetentionCount, "Maximum number of audit history entries kept, 0 keeps every entry")
+	flagutil.EnvDurationVar(&config.AuditRetentionAge, "AGENT_AUDIT_RETENTION_AGE", "audit-retention-age", 0, "How long audit history entries are kept, 0 keeps entries regardless of their age")
+	flagutil.EnvStringVar(&config.AuditFile, "AGENT_AUDIT_FILE", "audit-file", "", "JSON lines file the audit entries are appended to, empty disables it")
+	flagutil.EnvStringVar(&config.AuditSyslogAddress, "AGENT_AUDIT_SYSLOG", "audit-syslog", "", "Syslog server the audit entries are sent to (udp://host:port, tcp://host:port or unix:///path), empty disables it")
+	flagutil.EnvStringVar(&config.AuditWebhookURL, "AGENT_AUDIT_WEBHOOK", "audit-webhook", "", "URL the audit entries are posted to, empty disables it")
+	flagutil.EnvStringVar(&config.AuditSpoolDirectory, "AGENT_AUDIT_SPOOL_DIR", "audit-spool-dir", constants.AuditSpoolDirectory, "Directory of the audit entries not yet delivered to the audit sinks")
+	flagutil.EnvStringVar(&config.EncryptionKeyFile, "AGENT_ENCRYPTION_KEY_FILE", "encryption-key-file", "", "File of the keys encrypting the sensitive fields of the state store, the first key is the primary one, empty keeps them in plain text")
 	timeformat = flag.String("format", "02-01-2006 15:04:05.000 UTC", "time format")
 	flag.Parse()
 	ctx := log.WithLogger(context.Background(), timeformat)
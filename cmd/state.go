@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// stateCommands are the subcommands moving the agent state between nodes, they call the running agent.
+var stateCommands = map[string]func(ctx context.Context, client v1alpha1.AgentAPIClient, file string) error{
+	"export-state": exportState,
+	"import-state": importState,
+}
+
+// runStateCommand runs a state subcommand with its arguments, the subcommand has its own flags.
+func runStateCommand(ctx context.Context, name string, args []string) error {
+	command := stateCommands[name]
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	grpcAddr := flags.String("grpc-addr", envOr("AGENT_GRPC_CLIENT_ADDR", "127.0.0.1:50055"), "gRPC address of the running agent")
+	token := flags.String("token", os.Getenv("AGENT_TOKEN"), "Access token of an admin user, when the agent requires one")
+	file := flags.String("file", "-", "Archive file, - for standard output on export and standard input on import")
+	timeout := flags.Duration("timeout", time.Minute, "How long to wait for the agent")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, *grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("connect to agent %s: %w", *grpcAddr, err)
+	}
+	defer conn.Close()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", *token)
+	}
+	return command(ctx, v1alpha1.NewAgentAPIClient(conn), *file)
+}
+
+// exportState writes the archive of the agent state to the file.
+func exportState(ctx context.Context, client v1alpha1.AgentAPIClient, file string) error {
+	archive, err := client.ExportState(ctx, &v1alpha1.ExportStateRequest{})
+	if err != nil {
+		return fmt.Errorf("export state: %w", err)
+	}
+	if file == "-" {
+		_, err = os.Stdout.Write(archive.GetArchive())
+	} else {
+		err = os.WriteFile(file, archive.GetArchive(), constants.FileReadWriteAccess)
+	}
+	if err != nil {
+		return fmt.Errorf("write state archive: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Exported state, schema version %d, checksum %s\n", archive.GetSchemaVersion(), archive.GetChecksum())
+	return nil
+}
+
+// importState replaces the agent state with the state of the archive of the file.
+func importState(ctx context.Context, client v1alpha1.AgentAPIClient, file string) error {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return fmt.Errorf("read state archive: %w", err)
+	}
+	response, err := client.ImportState(ctx, &v1alpha1.ImportStateRequest{Archive: data})
+	if err != nil {
+		return fmt.Errorf("import state: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Imported state, schema version %d migrated to %d, checksum %s\n",
+		response.GetSchemaVersion(), response.GetMigratedSchemaVersion(), response.GetChecksum())
+	return nil
+}
+
+func envOr(envKey, value string) string {
+	if envVal := os.Getenv(envKey); envVal != "" {
+		return envVal
+	}
+	return value
+}
@@ -19,16 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AgentAPI_GetCluster_FullMethodName           = "/agent.v1alpha1.AgentAPI/GetCluster"
-	AgentAPI_CreateCluster_FullMethodName        = "/agent.v1alpha1.AgentAPI/CreateCluster"
-	AgentAPI_UpgradeCluster_FullMethodName       = "/agent.v1alpha1.AgentAPI/UpgradeCluster"
-	AgentAPI_PatchCluster_FullMethodName         = "/agent.v1alpha1.AgentAPI/PatchCluster"
-	AgentAPI_DeleteCluster_FullMethodName        = "/agent.v1alpha1.AgentAPI/DeleteCluster"
-	AgentAPI_AuditHistory_FullMethodName         = "/agent.v1alpha1.AgentAPI/AuditHistory"
-	AgentAPI_GetKubeconfig_FullMethodName        = "/agent.v1alpha1.AgentAPI/GetKubeconfig"
-	AgentAPI_ResetCerts_FullMethodName           = "/agent.v1alpha1.AgentAPI/ResetCerts"
-	AgentAPI_GetCerts_FullMethodName             = "/agent.v1alpha1.AgentAPI/GetCerts"
-	AgentAPI_GetReconcilerRequest_FullMethodName = "/agent.v1alpha1.AgentAPI/GetReconcilerRequest"
+	AgentAPI_GetCluster_FullMethodName            = "/agent.v1alpha1.AgentAPI/GetCluster"
+	AgentAPI_WatchCluster_FullMethodName          = "/agent.v1alpha1.AgentAPI/WatchCluster"
+	AgentAPI_CreateCluster_FullMethodName         = "/agent.v1alpha1.AgentAPI/CreateCluster"
+	AgentAPI_UpgradeCluster_FullMethodName        = "/agent.v1alpha1.AgentAPI/UpgradeCluster"
+	AgentAPI_PatchCluster_FullMethodName          = "/agent.v1alpha1.AgentAPI/PatchCluster"
+	AgentAPI_DeleteCluster_FullMethodName         = "/agent.v1alpha1.AgentAPI/DeleteCluster"
+	AgentAPI_AuditHistory_FullMethodName          = "/agent.v1alpha1.AgentAPI/AuditHistory"
+	AgentAPI_GetKubeconfig_FullMethodName         = "/agent.v1alpha1.AgentAPI/GetKubeconfig"
+	AgentAPI_ResetCerts_FullMethodName            = "/agent.v1alpha1.AgentAPI/ResetCerts"
+	AgentAPI_GetCerts_FullMethodName              = "/agent.v1alpha1.AgentAPI/GetCerts"
+	AgentAPI_GetReconcilerRequest_FullMethodName  = "/agent.v1alpha1.AgentAPI/GetReconcilerRequest"
+	AgentAPI_GetOperation_FullMethodName          = "/agent.v1alpha1.AgentAPI/GetOperation"
+	AgentAPI_ListOperations_FullMethodName        = "/agent.v1alpha1.AgentAPI/ListOperations"
+	AgentAPI_CancelOperation_FullMethodName       = "/agent.v1alpha1.AgentAPI/CancelOperation"
+	AgentAPI_ValidateCluster_FullMethodName       = "/agent.v1alpha1.AgentAPI/ValidateCluster"
+	AgentAPI_PlanCluster_FullMethodName           = "/agent.v1alpha1.AgentAPI/PlanCluster"
+	AgentAPI_ListAvailableVersions_FullMethodName = "/agent.v1alpha1.AgentAPI/ListAvailableVersions"
+	AgentAPI_GetUpgradePaths_FullMethodName       = "/agent.v1alpha1.AgentAPI/GetUpgradePaths"
+	AgentAPI_ExecuteScript_FullMethodName         = "/agent.v1alpha1.AgentAPI/ExecuteScript"
+	AgentAPI_ListSpecRevisions_FullMethodName     = "/agent.v1alpha1.AgentAPI/ListSpecRevisions"
+	AgentAPI_RevertClusterSpec_FullMethodName     = "/agent.v1alpha1.AgentAPI/RevertClusterSpec"
+	AgentAPI_ExportState_FullMethodName           = "/agent.v1alpha1.AgentAPI/ExportState"
+	AgentAPI_ImportState_FullMethodName           = "/agent.v1alpha1.AgentAPI/ImportState"
 )
 
 // AgentAPIClient is the client API for AgentAPI service.
@@ -37,6 +50,10 @@ const (
 type AgentAPIClient interface {
 	// Get the current cluster configuration.
 	GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
+	// Follow the cluster, an event is sent whenever its phase, conditions or spec change. Through the
+	// gateway the events are streamed as chunked JSON, or as Server-Sent Events to a client accepting
+	// text/event-stream.
+	WatchCluster(ctx context.Context, in *WatchClusterRequest, opts ...grpc.CallOption) (AgentAPI_WatchClusterClient, error)
 	// Create a single node cluster.
 	CreateCluster(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
 	// Upgrade a single node cluster.
@@ -55,6 +72,37 @@ type AgentAPIClient interface {
 	GetCerts(ctx context.Context, in *ClusterCertificateRequest, opts ...grpc.CallOption) (*ClusterCertificatesResponse, error)
 	// Get the status of cluster reconciler
 	GetReconcilerRequest(ctx context.Context, in *GetClusterStatusReconcilerRequest, opts ...grpc.CallOption) (*GetClusterStatusReconcilerResponse, error)
+	// Get an operation started by a cluster mutation
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// List the operations started by cluster mutations
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+	// Cancel an in-flight operation, the tasks it executed are rolled back
+	CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// Validate a cluster spec without creating the cluster. An invalid spec is refused with an
+	// InvalidArgument status whose BadRequest detail lists every invalid field.
+	ValidateCluster(ctx context.Context, in *ValidateClusterRequest, opts ...grpc.CallOption) (*ValidateClusterResponse, error)
+	// Plan a cluster mutation, returning the tasks it would run and the changes each task would
+	// make to the node, without changing anything.
+	PlanCluster(ctx context.Context, in *PlanClusterRequest, opts ...grpc.CallOption) (*PlanClusterResponse, error)
+	// List the Kubernetes versions the node can install, from the package repositories configured on
+	// the node, the offline version catalog and the control plane images cached by containerd.
+	ListAvailableVersions(ctx context.Context, in *ListAvailableVersionsRequest, opts ...grpc.CallOption) (*ListAvailableVersionsResponse, error)
+	// List the versions the cluster can be upgraded to in one step. The Kubernetes version skew policy
+	// allows a newer patch of the current minor version or a version of the next minor version.
+	GetUpgradePaths(ctx context.Context, in *GetUpgradePathsRequest, opts ...grpc.CallOption) (*GetUpgradePathsResponse, error)
+	// Run a script from the agent's script directory on the node.
+	ExecuteScript(ctx context.Context, in *ExecuteScriptRequest, opts ...grpc.CallOption) (*ExecuteScriptResponse, error)
+	// List the cluster specs accepted by cluster mutations, one revision per accepted spec.
+	ListSpecRevisions(ctx context.Context, in *ListSpecRevisionsRequest, opts ...grpc.CallOption) (*ListSpecRevisionsResponse, error)
+	// Move the cluster back to the spec of a revision. A revision with another Kubernetes version is
+	// reverted by an upgrade, any other revision by a patch.
+	RevertClusterSpec(ctx context.Context, in *RevertClusterSpecRequest, opts ...grpc.CallOption) (*Cluster, error)
+	// Export the agent state, the cluster spec and status, the audit history, the operations, the spec
+	// revisions and the stored config maps, as a versioned and checksummed archive.
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*StateArchive, error)
+	// Replace the agent state with the state of an archive made by ExportState. The import is refused
+	// while an operation is in progress and for an archive exported by a newer agent.
+	ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error)
 }
 
 type agentAPIClient struct {
@@ -74,6 +122,38 @@ func (c *agentAPIClient) GetCluster(ctx context.Context, in *GetClusterRequest,
 	return out, nil
 }
 
+func (c *agentAPIClient) WatchCluster(ctx context.Context, in *WatchClusterRequest, opts ...grpc.CallOption) (AgentAPI_WatchClusterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentAPI_ServiceDesc.Streams[0], AgentAPI_WatchCluster_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentAPIWatchClusterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AgentAPI_WatchClusterClient interface {
+	Recv() (*WatchClusterEvent, error)
+	grpc.ClientStream
+}
+
+type agentAPIWatchClusterClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentAPIWatchClusterClient) Recv() (*WatchClusterEvent, error) {
+	m := new(WatchClusterEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *agentAPIClient) CreateCluster(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
 	out := new(Cluster)
 	err := c.cc.Invoke(ctx, AgentAPI_CreateCluster_FullMethodName, in, out, opts...)
@@ -155,12 +235,124 @@ func (c *agentAPIClient) GetReconcilerRequest(ctx context.Context, in *GetCluste
 	return out, nil
 }
 
+func (c *agentAPIClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, AgentAPI_GetOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_ListOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) CancelOperation(ctx context.Context, in *CancelOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, AgentAPI_CancelOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) ValidateCluster(ctx context.Context, in *ValidateClusterRequest, opts ...grpc.CallOption) (*ValidateClusterResponse, error) {
+	out := new(ValidateClusterResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_ValidateCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) PlanCluster(ctx context.Context, in *PlanClusterRequest, opts ...grpc.CallOption) (*PlanClusterResponse, error) {
+	out := new(PlanClusterResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_PlanCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) ListAvailableVersions(ctx context.Context, in *ListAvailableVersionsRequest, opts ...grpc.CallOption) (*ListAvailableVersionsResponse, error) {
+	out := new(ListAvailableVersionsResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_ListAvailableVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) GetUpgradePaths(ctx context.Context, in *GetUpgradePathsRequest, opts ...grpc.CallOption) (*GetUpgradePathsResponse, error) {
+	out := new(GetUpgradePathsResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_GetUpgradePaths_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) ExecuteScript(ctx context.Context, in *ExecuteScriptRequest, opts ...grpc.CallOption) (*ExecuteScriptResponse, error) {
+	out := new(ExecuteScriptResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_ExecuteScript_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) ListSpecRevisions(ctx context.Context, in *ListSpecRevisionsRequest, opts ...grpc.CallOption) (*ListSpecRevisionsResponse, error) {
+	out := new(ListSpecRevisionsResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_ListSpecRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) RevertClusterSpec(ctx context.Context, in *RevertClusterSpecRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, AgentAPI_RevertClusterSpec_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*StateArchive, error) {
+	out := new(StateArchive)
+	err := c.cc.Invoke(ctx, AgentAPI_ExportState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentAPIClient) ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error) {
+	out := new(ImportStateResponse)
+	err := c.cc.Invoke(ctx, AgentAPI_ImportState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentAPIServer is the server API for AgentAPI service.
 // All implementations must embed UnimplementedAgentAPIServer
 // for forward compatibility
 type AgentAPIServer interface {
 	// Get the current cluster configuration.
 	GetCluster(context.Context, *GetClusterRequest) (*Cluster, error)
+	// Follow the cluster, an event is sent whenever its phase, conditions or spec change. Through the
+	// gateway the events are streamed as chunked JSON, or as Server-Sent Events to a client accepting
+	// text/event-stream.
+	WatchCluster(*WatchClusterRequest, AgentAPI_WatchClusterServer) error
 	// Create a single node cluster.
 	CreateCluster(context.Context, *CreateClusterRequest) (*Cluster, error)
 	// Upgrade a single node cluster.
@@ -179,6 +371,37 @@ type AgentAPIServer interface {
 	GetCerts(context.Context, *ClusterCertificateRequest) (*ClusterCertificatesResponse, error)
 	// Get the status of cluster reconciler
 	GetReconcilerRequest(context.Context, *GetClusterStatusReconcilerRequest) (*GetClusterStatusReconcilerResponse, error)
+	// Get an operation started by a cluster mutation
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	// List the operations started by cluster mutations
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	// Cancel an in-flight operation, the tasks it executed are rolled back
+	CancelOperation(context.Context, *CancelOperationRequest) (*Operation, error)
+	// Validate a cluster spec without creating the cluster. An invalid spec is refused with an
+	// InvalidArgument status whose BadRequest detail lists every invalid field.
+	ValidateCluster(context.Context, *ValidateClusterRequest) (*ValidateClusterResponse, error)
+	// Plan a cluster mutation, returning the tasks it would run and the changes each task would
+	// make to the node, without changing anything.
+	PlanCluster(context.Context, *PlanClusterRequest) (*PlanClusterResponse, error)
+	// List the Kubernetes versions the node can install, from the package repositories configured on
+	// the node, the offline version catalog and the control plane images cached by containerd.
+	ListAvailableVersions(context.Context, *ListAvailableVersionsRequest) (*ListAvailableVersionsResponse, error)
+	// List the versions the cluster can be upgraded to in one step. The Kubernetes version skew policy
+	// allows a newer patch of the current minor version or a version of the next minor version.
+	GetUpgradePaths(context.Context, *GetUpgradePathsRequest) (*GetUpgradePathsResponse, error)
+	// Run a script from the agent's script directory on the node.
+	ExecuteScript(context.Context, *ExecuteScriptRequest) (*ExecuteScriptResponse, error)
+	// List the cluster specs accepted by cluster mutations, one revision per accepted spec.
+	ListSpecRevisions(context.Context, *ListSpecRevisionsRequest) (*ListSpecRevisionsResponse, error)
+	// Move the cluster back to the spec of a revision. A revision with another Kubernetes version is
+	// reverted by an upgrade, any other revision by a patch.
+	RevertClusterSpec(context.Context, *RevertClusterSpecRequest) (*Cluster, error)
+	// Export the agent state, the cluster spec and status, the audit history, the operations, the spec
+	// revisions and the stored config maps, as a versioned and checksummed archive.
+	ExportState(context.Context, *ExportStateRequest) (*StateArchive, error)
+	// Replace the agent state with the state of an archive made by ExportState. The import is refused
+	// while an operation is in progress and for an archive exported by a newer agent.
+	ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error)
 	mustEmbedUnimplementedAgentAPIServer()
 }
 
@@ -189,6 +412,9 @@ type UnimplementedAgentAPIServer struct {
 func (UnimplementedAgentAPIServer) GetCluster(context.Context, *GetClusterRequest) (*Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
 }
+func (UnimplementedAgentAPIServer) WatchCluster(*WatchClusterRequest, AgentAPI_WatchClusterServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCluster not implemented")
+}
 func (UnimplementedAgentAPIServer) CreateCluster(context.Context, *CreateClusterRequest) (*Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateCluster not implemented")
 }
@@ -216,6 +442,42 @@ func (UnimplementedAgentAPIServer) GetCerts(context.Context, *ClusterCertificate
 func (UnimplementedAgentAPIServer) GetReconcilerRequest(context.Context, *GetClusterStatusReconcilerRequest) (*GetClusterStatusReconcilerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReconcilerRequest not implemented")
 }
+func (UnimplementedAgentAPIServer) GetOperation(context.Context, *GetOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedAgentAPIServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedAgentAPIServer) CancelOperation(context.Context, *CancelOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedAgentAPIServer) ValidateCluster(context.Context, *ValidateClusterRequest) (*ValidateClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCluster not implemented")
+}
+func (UnimplementedAgentAPIServer) PlanCluster(context.Context, *PlanClusterRequest) (*PlanClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanCluster not implemented")
+}
+func (UnimplementedAgentAPIServer) ListAvailableVersions(context.Context, *ListAvailableVersionsRequest) (*ListAvailableVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAvailableVersions not implemented")
+}
+func (UnimplementedAgentAPIServer) GetUpgradePaths(context.Context, *GetUpgradePathsRequest) (*GetUpgradePathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpgradePaths not implemented")
+}
+func (UnimplementedAgentAPIServer) ExecuteScript(context.Context, *ExecuteScriptRequest) (*ExecuteScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScript not implemented")
+}
+func (UnimplementedAgentAPIServer) ListSpecRevisions(context.Context, *ListSpecRevisionsRequest) (*ListSpecRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpecRevisions not implemented")
+}
+func (UnimplementedAgentAPIServer) RevertClusterSpec(context.Context, *RevertClusterSpecRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertClusterSpec not implemented")
+}
+func (UnimplementedAgentAPIServer) ExportState(context.Context, *ExportStateRequest) (*StateArchive, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportState not implemented")
+}
+func (UnimplementedAgentAPIServer) ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportState not implemented")
+}
 func (UnimplementedAgentAPIServer) mustEmbedUnimplementedAgentAPIServer() {}
 
 // UnsafeAgentAPIServer may be embedded to opt out of forward compatibility for this service.
@@ -247,6 +509,27 @@ func _AgentAPI_GetCluster_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentAPI_WatchCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClusterRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentAPIServer).WatchCluster(m, &agentAPIWatchClusterServer{stream})
+}
+
+type AgentAPI_WatchClusterServer interface {
+	Send(*WatchClusterEvent) error
+	grpc.ServerStream
+}
+
+type agentAPIWatchClusterServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentAPIWatchClusterServer) Send(m *WatchClusterEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AgentAPI_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateClusterRequest)
 	if err := dec(in); err != nil {
@@ -409,6 +692,222 @@ func _AgentAPI_GetReconcilerRequest_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentAPI_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).CancelOperation(ctx, req.(*CancelOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_ValidateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).ValidateCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_ValidateCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).ValidateCluster(ctx, req.(*ValidateClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_PlanCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).PlanCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_PlanCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).PlanCluster(ctx, req.(*PlanClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_ListAvailableVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAvailableVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).ListAvailableVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_ListAvailableVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).ListAvailableVersions(ctx, req.(*ListAvailableVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_GetUpgradePaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpgradePathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).GetUpgradePaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_GetUpgradePaths_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).GetUpgradePaths(ctx, req.(*GetUpgradePathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_ExecuteScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteScriptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).ExecuteScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_ExecuteScript_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).ExecuteScript(ctx, req.(*ExecuteScriptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_ListSpecRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpecRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).ListSpecRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_ListSpecRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).ListSpecRevisions(ctx, req.(*ListSpecRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_RevertClusterSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertClusterSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).RevertClusterSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_RevertClusterSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).RevertClusterSpec(ctx, req.(*RevertClusterSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).ExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_ExportState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).ExportState(ctx, req.(*ExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentAPI_ImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAPIServer).ImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAPI_ImportState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAPIServer).ImportState(ctx, req.(*ImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentAPI_ServiceDesc is the grpc.ServiceDesc for AgentAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -456,7 +955,61 @@ var AgentAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReconcilerRequest",
 			Handler:    _AgentAPI_GetReconcilerRequest_Handler,
 		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _AgentAPI_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _AgentAPI_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _AgentAPI_CancelOperation_Handler,
+		},
+		{
+			MethodName: "ValidateCluster",
+			Handler:    _AgentAPI_ValidateCluster_Handler,
+		},
+		{
+			MethodName: "PlanCluster",
+			Handler:    _AgentAPI_PlanCluster_Handler,
+		},
+		{
+			MethodName: "ListAvailableVersions",
+			Handler:    _AgentAPI_ListAvailableVersions_Handler,
+		},
+		{
+			MethodName: "GetUpgradePaths",
+			Handler:    _AgentAPI_GetUpgradePaths_Handler,
+		},
+		{
+			MethodName: "ExecuteScript",
+			Handler:    _AgentAPI_ExecuteScript_Handler,
+		},
+		{
+			MethodName: "ListSpecRevisions",
+			Handler:    _AgentAPI_ListSpecRevisions_Handler,
+		},
+		{
+			MethodName: "RevertClusterSpec",
+			Handler:    _AgentAPI_RevertClusterSpec_Handler,
+		},
+		{
+			MethodName: "ExportState",
+			Handler:    _AgentAPI_ExportState_Handler,
+		},
+		{
+			MethodName: "ImportState",
+			Handler:    _AgentAPI_ImportState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCluster",
+			Handler:       _AgentAPI_WatchCluster_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "agent/v1alpha1/agent.proto",
 }
@@ -13,17 +13,25 @@ import (
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/cluster"
 	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/operations"
 	"kubeclusteragent/pkg/reconciler/certsreconciler"
 	"kubeclusteragent/pkg/reconciler/statusreconciler"
 	"kubeclusteragent/pkg/tools/patchtool"
+	"kubeclusteragent/pkg/util/auditsink"
 	"kubeclusteragent/pkg/util/auth"
+	"kubeclusteragent/pkg/util/db"
+	errorutil "kubeclusteragent/pkg/util/error"
 	"kubeclusteragent/pkg/util/go"
 	grpcutil2 "kubeclusteragent/pkg/util/grpc"
+	"kubeclusteragent/pkg/util/idempotency"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
 	"kubeclusteragent/pkg/util/reconcile"
+	"kubeclusteragent/pkg/util/script"
+	"kubeclusteragent/pkg/util/secret"
 	"net"
 	"os"
+	"path/filepath"
 )
 
 type App struct {
@@ -58,9 +66,42 @@ func (a *App) Start(ctx context.Context) error {
 		logger.Error(err, "unable to create resource directory for cluster certs")
 		return err
 	}
+	stateFilePath := a.config.StateFilePath
+	if stateFilePath == "" {
+		stateFilePath = db.DBFilePath
+	}
+	stateStore, err := db.Open(stateFilePath)
+	if err != nil {
+		logger.Error(err, "unable to open the cluster state store", "path", stateFilePath)
+		return err
+	}
+	defer stateStore.Close()
+	// the state an older agent wrote is migrated before anything reads it, the state of a newer one is refused
+	if err := cluster.MigrateStore(ctx, stateStore); err != nil {
+		logger.Error(err, "unable to migrate the cluster state store")
+		return err
+	}
+	if err := a.configureEncryption(ctx, stateStore); err != nil {
+		logger.Error(err, "unable to encrypt the sensitive fields of the cluster state store")
+		return err
+	}
+	auditForwarder, err := a.auditForwarder()
+	if err != nil {
+		logger.Error(err, "unable to configure the audit sinks")
+		return err
+	}
 	logger.Info("Starting application")
 	runCtx, runCancel := context.WithCancel(ctx)
 	defer runCancel()
+	if auditForwarder != nil {
+		auditForwarder.Start(runCtx)
+		cluster.SetAuditPublisher(auditForwarder)
+		// the forwarder stops once runCtx is cancelled
+		defer func() {
+			runCancel()
+			auditForwarder.Wait()
+		}()
+	}
 	handles, err := a.initGRPC(runCtx)
 	if err != nil {
 		return err
@@ -72,6 +113,48 @@ func (a *App) Start(ctx context.Context) error {
 	return nil
 }
 
+// configureEncryption encrypts the sensitive fields of the state store with the keys of the key file,
+// the records written in plain text or with an older key are encrypted again with the primary key.
+// The sensitive fields are redacted from the logs from then on.
+func (a *App) configureEncryption(ctx context.Context, stateStore db.Backend) error {
+	log.SetRedactor(secret.RedactString)
+	if a.config.EncryptionKeyFile != "" {
+		provider, err := secret.NewFileKeyProvider(a.config.EncryptionKeyFile)
+		if err != nil {
+			return err
+		}
+		cluster.SetEncryption(secret.NewEnvelope(provider))
+	}
+	return cluster.EncryptStore(ctx, stateStore)
+}
+
+// auditForwarder returns the forwarder of the audit entries to the configured audit sinks, nil when
+// no sink is configured.
+func (a *App) auditForwarder() (*auditsink.Forwarder, error) {
+	var sinks []auditsink.Sink
+	if a.config.AuditFile != "" {
+		sinks = append(sinks, auditsink.NewFileSink(a.config.AuditFile))
+	}
+	if a.config.AuditSyslogAddress != "" {
+		syslogSink, err := auditsink.NewSyslogSink(a.config.AuditSyslogAddress)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, syslogSink)
+	}
+	if a.config.AuditWebhookURL != "" {
+		sinks = append(sinks, auditsink.NewWebhookSink(a.config.AuditWebhookURL, nil))
+	}
+	if len(sinks) == 0 {
+		return nil, nil
+	}
+	spoolDirectory := a.config.AuditSpoolDirectory
+	if spoolDirectory == "" {
+		spoolDirectory = constants.AuditSpoolDirectory
+	}
+	return auditsink.NewForwarder(spoolDirectory, sinks...)
+}
+
 func (a *App) initGRPC(ctx context.Context) ([]<-chan struct{}, error) {
 	grpcServerDone, err := a.startGRPC(ctx)
 	if err != nil {
@@ -85,6 +168,31 @@ func (a *App) initGRPC(ctx context.Context) ([]<-chan struct{}, error) {
 	return handles, nil
 }
 
+// scriptRunner returns the runner of the script directory and enables the operation hooks it holds.
+// Scripts and hooks are disabled when no script directory is configured.
+func (a *App) scriptRunner() *script.Runner {
+	if a.config.ScriptDirectory == "" {
+		operations.SetHookDirectory("")
+		return nil
+	}
+	operations.SetHookDirectory(filepath.Join(a.config.ScriptDirectory, constants.HooksDirectory))
+	var ou linux.OSUtil = linux.New()
+	if a.config.DryRun {
+		ou = linux.NewDryRun()
+	}
+	return script.NewRunner(a.config.ScriptDirectory, ou.Exec())
+}
+
+// idempotentMethods are the cluster mutations replayed for a repeated idempotency key.
+var idempotentMethods = []string{
+	v1alpha1.AgentAPI_CreateCluster_FullMethodName,
+	v1alpha1.AgentAPI_UpgradeCluster_FullMethodName,
+	v1alpha1.AgentAPI_PatchCluster_FullMethodName,
+	v1alpha1.AgentAPI_DeleteCluster_FullMethodName,
+	v1alpha1.AgentAPI_ResetCerts_FullMethodName,
+	v1alpha1.AgentAPI_RevertClusterSpec_FullMethodName,
+}
+
 func (a *App) startGRPC(ctx context.Context) (<-chan struct{}, error) {
 	logger := log.From(ctx).WithName("App")
 	var err error
@@ -98,14 +206,23 @@ func (a *App) startGRPC(ctx context.Context) (<-chan struct{}, error) {
 	}
 
 	jwtManager := *auth.CreateJwtManager(a.config.TokenSharedKey)
-	// If current status of the Cluster is in any of the Intermediate states like Provisioning,Updating,Deleting automatically it will be marked as Failed on start-up
+	operations.SetMaxParallelTasks(a.config.MaxParallelTasks)
+	cluster.SetAuditRetention(a.config.AuditRetentionCount, a.config.AuditRetentionAge)
+	// An operation interrupted by a restart leaves a checkpoint behind, it is resumed or rolled back once the service is up.
+	// Without a checkpoint, a cluster in any of the Intermediate states like Provisioning,Updating,Deleting is marked as Failed on start-up
+	checkpoint, err := clusterStatus.GetCheckpoint(ctx)
+	if err != nil {
+		logger.Error(err, "unable to read operation checkpoint")
+	}
+	if err := operations.ReleaseStaleLock(ctx, clusterStatus, checkpoint); err != nil {
+		logger.Error(err, "unable to release the operation lock")
+	}
 	currentClusterStatus := clusterStatus.GetStatus(ctx)
-	if currentClusterStatus != nil && (currentClusterStatus.Phase == constants.ClusterPhaseProvisioning ||
+	if checkpoint == nil && currentClusterStatus != nil && (currentClusterStatus.Phase == constants.ClusterPhaseProvisioning ||
 		currentClusterStatus.Phase == constants.ClusterPhaseUpgrading ||
 		currentClusterStatus.Phase == constants.ClusterPhaseDeleting ||
 		currentClusterStatus.Phase == constants.ClusterPhaseKubeConfigResetting) {
 		logger.Info("current cluster phase is an intermediate phase marking it as failed", "phase", currentClusterStatus.Phase)
-		// TODO start the failed task once again
 		currentClusterStatus.Phase = constants.ClusterPhaseFailed
 		clusterStatus.SetStatus(ctx, currentClusterStatus)
 	} else if currentClusterStatus != nil && currentClusterStatus.Phase == constants.ClusterPhaseProvisioned {
@@ -125,7 +242,10 @@ func (a *App) startGRPC(ctx context.Context) (<-chan struct{}, error) {
 			return nil, err
 		}
 	}
-	svc := NewLiveService(ctx, patchtool.NewClusterConfigInstallTool(clusterStatus, a.config.DryRun), jwtManager, reconcilerRegistery)
+	svc := NewLiveService(ctx, patchtool.NewClusterConfigInstallTool(clusterStatus, a.config.DryRun), jwtManager, reconcilerRegistery, a.scriptRunner())
+	if checkpoint != nil {
+		a.resumeInterruptedOperation(ctx, svc, clusterStatus, checkpoint)
+	}
 	// if kubeconfig is present, then register the reconcile to get the heart beat of the cluster
 	if kc, err := svc.InstallTool.Config(ctx); err == nil {
 		if svc.ReconcileRegistry.GetReconciler(statusreconciler.ClusterStatusReconcilerName) == nil && kc != nil {
@@ -161,16 +281,70 @@ func (a *App) startGRPC(ctx context.Context) (<-chan struct{}, error) {
 		logger.Error(err, "unable to load tls credentials")
 		return nil, fmt.Errorf("unable to load tls credentials: %w", err)
 	}
-	serverOptions := []grpc.ServerOption{
-		grpc.ChainUnaryInterceptor(unaryInterceptor, prometheus.NewServerMetrics().UnaryServerInterceptor()),
-	}
-	ch, err := server.StartWithMetricsServer(ctx, a.config.ServerCertFilePath, a.config.ServerKeyFilePath, a.config.TokenSharedKey, serverOptions...)
+	ch, err := server.StartWithMetricsServer(ctx, a.config.ServerCertFilePath, a.config.ServerKeyFilePath, a.config.TokenSharedKey, a.serverOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("start server: %w", err)
 	}
 	return ch, nil
 }
 
+// privilegedMethods run scripts on the host or read and replace its whole state, they are always authorized.
+var privilegedMethods = []string{
+	v1alpha1.AgentAPI_ExecuteScript_FullMethodName,
+	v1alpha1.AgentAPI_ExportState_FullMethodName,
+	v1alpha1.AgentAPI_ImportState_FullMethodName,
+}
+
+// serverOptions returns the interceptors of the gRPC server.
+func (a *App) serverOptions() []grpc.ServerOption {
+	jwtManager := auth.CreateJwtManager(a.config.TokenSharedKey)
+	authInterceptor := auth.CreateAuthInterceptorForMethods(jwtManager, privilegedMethods...)
+	if a.config.AuthorizeRequests {
+		authInterceptor = auth.CreateAuthInterceptor(jwtManager)
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptor, prometheus.NewServerMetrics().UnaryServerInterceptor(),
+			// every error gets a status with an ErrorInfo detail, the metrics record its code
+			errorutil.UnaryServerInterceptor(),
+			// the requests are authorized before anything is recorded or run for them
+			authInterceptor.Unary(),
+			idempotency.UnaryServerInterceptor(idempotency.NewLiveStore(), a.config.IdempotencyKeyTTL, idempotentMethods...),
+			// innermost, the responses recorded for idempotency keys are redacted too
+			secret.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(prometheus.NewServerMetrics().StreamServerInterceptor(), errorutil.StreamServerInterceptor(),
+			authInterceptor.Stream(), secret.StreamServerInterceptor()),
+	}
+}
+
+// resumeInterruptedOperation hands an operation interrupted by a restart back to the tool that started it.
+// If it cannot be resumed, the cluster is marked as failed as it was before checkpoints existed.
+func (a *App) resumeInterruptedOperation(ctx context.Context, svc *LiveService, clusterStatus cluster.Status, checkpoint *cluster.Checkpoint) {
+	logger := log.From(ctx).WithName("App").WithValues("operation", checkpoint.Operation, "id", checkpoint.OperationID,
+		"lastCompletedTask", checkpoint.LastCompletedTask)
+	rollback := a.config.RollbackInterruptedOperations
+	if rollback {
+		logger.Info("rolling back operation interrupted by agent restart")
+	} else {
+		logger.Info("resuming operation interrupted by agent restart")
+	}
+	var err error
+	if checkpoint.Operation == constants.OperationPatch {
+		err = svc.patchTool.Resume(ctx, checkpoint, rollback)
+	} else {
+		err = kubeToolFactory.GetKubernetesToolByProvider(ctx, checkpoint.ClusterSpec.GetClusterType()).Resume(ctx, checkpoint, rollback)
+	}
+	if err == nil {
+		return
+	}
+	logger.Error(err, "unable to resume interrupted operation, marking it as failed")
+	currentClusterStatus := clusterStatus.GetStatus(ctx)
+	currentClusterStatus.Phase = constants.ClusterPhaseFailed
+	clusterStatus.SetStatus(ctx, currentClusterStatus)
+	if err := clusterStatus.DeleteCheckpoint(ctx); err != nil {
+		logger.Error(err, "unable to delete operation checkpoint")
+	}
+}
+
 func (a *App) startGateway(ctx context.Context) (<-chan struct{}, error) {
 	config := grpcutil2.GatewayConfig{
 		ServerAddr: a.config.GRPCAddr,
@@ -184,6 +358,9 @@ func (a *App) startGateway(ctx context.Context) (<-chan struct{}, error) {
 	g := grpcutil2.NewGateway("GRPCGateway", config, GRPCDialOptions)
 
 	options := []runtime.ServeMuxOption{
+		runtime.WithIncomingHeaderMatcher(grpcutil2.IfMatchHeaderMatcher(grpcutil2.EventStreamHeaderMatcher(idempotency.IncomingHeaderMatcher))),
+		runtime.WithOutgoingHeaderMatcher(grpcutil2.ETagHeaderMatcher(idempotency.OutgoingHeaderMatcher)),
+		runtime.WithErrorHandler(errorutil.HTTPErrorHandler),
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 			MarshalOptions: protojson.MarshalOptions{
 				Multiline: true,
@@ -193,6 +370,9 @@ func (a *App) startGateway(ctx context.Context) (<-chan struct{}, error) {
 				DiscardUnknown: true,
 			},
 		}),
+		// the events of a watch are streamed one per line, or as Server-Sent Events, to the clients accepting them
+		runtime.WithMarshalerOption(grpcutil2.NDJSONContentType, &runtime.JSONPb{}),
+		runtime.WithMarshalerOption(grpcutil2.EventStreamContentType, &grpcutil2.EventStreamMarshaler{}),
 	}
 
 	ch, err := g.Start(ctx, a.config.ServerCertFilePath, a.config.ServerKeyFilePath, options...)
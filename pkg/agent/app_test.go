@@ -0,0 +1,113 @@
+package agent
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/util/auth"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testTokenSharedKey = "test-secret-key"
+
+// unimplementedServer answers every request with Unimplemented, a request reaching it was authorized.
+type unimplementedServer struct {
+	v1alpha1.UnimplementedAgentAPIServer
+}
+
+// newTestClient serves the API behind the interceptors of the agent.
+func newTestClient(t *testing.T, authorizeRequests bool) v1alpha1.AgentAPIClient {
+	app := New(Config{TokenSharedKey: testTokenSharedKey, AuthorizeRequests: authorizeRequests})
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(app.serverOptions()...)
+	v1alpha1.RegisterAgentAPIServer(server, &unimplementedServer{})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return v1alpha1.NewAgentAPIClient(conn)
+}
+
+func withToken(t *testing.T, role string) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.UserClaims{Username: role + "-user", Role: role}).
+		SignedString([]byte(testTokenSharedKey))
+	require.NoError(t, err)
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", token)
+}
+
+func TestServerOptions_ExecuteScriptIsAdminOnly(t *testing.T) {
+	client := newTestClient(t, false)
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{name: "no token", ctx: context.Background(), want: codes.Unauthenticated},
+		{name: "view token", ctx: withToken(t, "view"), want: codes.PermissionDenied},
+		{name: "admin token", ctx: withToken(t, "admin"), want: codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ExecuteScript(tt.ctx, &v1alpha1.ExecuteScriptRequest{})
+			require.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
+
+func TestServerOptions_StateIsAdminOnly(t *testing.T) {
+	client := newTestClient(t, false)
+	calls := map[string]func(ctx context.Context) error{
+		"ExportState": func(ctx context.Context) error {
+			_, err := client.ExportState(ctx, &v1alpha1.ExportStateRequest{})
+			return err
+		},
+		"ImportState": func(ctx context.Context) error {
+			_, err := client.ImportState(ctx, &v1alpha1.ImportStateRequest{})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, codes.Unauthenticated, status.Code(call(context.Background())))
+			require.Equal(t, codes.PermissionDenied, status.Code(call(withToken(t, "view"))))
+			require.Equal(t, codes.Unimplemented, status.Code(call(withToken(t, "admin"))))
+		})
+	}
+}
+
+func TestServerOptions_AuthorizeRequests(t *testing.T) {
+	tests := []struct {
+		name              string
+		authorizeRequests bool
+		ctx               context.Context
+		want              codes.Code
+	}{
+		{name: "not required, no token", ctx: context.Background(), want: codes.Unimplemented},
+		{name: "required, no token", authorizeRequests: true, ctx: context.Background(), want: codes.Unauthenticated},
+		{name: "required, view token", authorizeRequests: true, ctx: withToken(t, "view"), want: codes.Unimplemented},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestClient(t, tt.authorizeRequests)
+			_, err := client.GetCluster(tt.ctx, &v1alpha1.GetClusterRequest{})
+			require.Equal(t, tt.want, status.Code(err))
+			if tt.authorizeRequests {
+				_, err = client.DeleteCluster(withToken(t, "view"), &v1alpha1.DeleteClusterRequest{})
+				require.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
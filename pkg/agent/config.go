@@ -1,5 +1,7 @@
 package agent
 
+import "time"
+
 // Config is configuration for the automation app.
 type Config struct {
 	// GRPCAddr is the address of the GRPC server.
@@ -17,6 +19,10 @@ type Config struct {
 	// TokenSharedKey is the shared key for verifying tokens.
 	TokenSharedKey string
 
+	// AuthorizeRequests requires a token of an allowed role on every request. When false only the
+	// requests running scripts or exporting and importing the state are authorized.
+	AuthorizeRequests bool
+
 	// ServerKeyFilePath points to the server key used for tls.
 	ServerKeyFilePath string
 
@@ -27,4 +33,40 @@ type Config struct {
 	CACertFilePath string
 	// PrimaryNetwork Interface
 	PrimaryNetworkInterface string
+
+	// RollbackInterruptedOperations rolls back an operation interrupted by a restart instead of resuming it.
+	RollbackInterruptedOperations bool
+
+	// ScriptDirectory holds the scripts that can be run through the API and, in its hooks
+	// directory, the operation hooks. Scripts are disabled when empty.
+	ScriptDirectory string
+
+	// MaxParallelTasks bounds the tasks of an operation running at the same time.
+	MaxParallelTasks int
+
+	// IdempotencyKeyTTL is how long the response of a request made with an idempotency key is replayed.
+	IdempotencyKeyTTL time.Duration
+
+	// AuditRetentionCount bounds the entries of the audit history kept, 0 keeps every entry.
+	AuditRetentionCount int
+
+	// AuditRetentionAge is how long an audit entry is kept, 0 keeps entries regardless of their age.
+	AuditRetentionAge time.Duration
+
+	// AuditFile is the JSON lines file the audit entries are appended to, disabled when empty.
+	AuditFile string
+
+	// AuditSyslogAddress is the syslog server the audit entries are sent to, udp://host:port,
+	// tcp://host:port or unix:///path, disabled when empty.
+	AuditSyslogAddress string
+
+	// AuditWebhookURL is the URL the audit entries are posted to, disabled when empty.
+	AuditWebhookURL string
+
+	// AuditSpoolDirectory holds the audit entries not yet delivered to the audit sinks.
+	AuditSpoolDirectory string
+
+	// EncryptionKeyFile holds the keys encrypting the sensitive fields of the state store, they are
+	// kept in plain text when empty.
+	EncryptionKeyFile string
 }
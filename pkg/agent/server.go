@@ -25,6 +25,10 @@ func NewServer(service Service) *Server {
 func (s Server) GetCluster(ctx context.Context, _ *v1alpha1.GetClusterRequest) (*v1alpha1.Cluster, error) {
 	return s.service.GetCluster(ctx)
 }
+func (s Server) WatchCluster(request *v1alpha1.WatchClusterRequest, stream v1alpha1.AgentAPI_WatchClusterServer) error {
+	return s.service.WatchCluster(stream.Context(), request.GetRevision(), stream.Send)
+}
+
 func (s Server) CreateCluster(ctx context.Context, request *v1alpha1.CreateClusterRequest) (*v1alpha1.Cluster, error) {
 	return s.service.CreateCluster(ctx, request)
 }
@@ -53,9 +57,57 @@ func (s Server) GetCerts(ctx context.Context, _ *v1alpha1.ClusterCertificateRequ
 }
 
 func (s Server) AuditHistory(ctx context.Context, request *v1alpha1.AuditHistoryRequest) (*v1alpha1.AuditHistoryResponse, error) {
-	return s.service.Audit(ctx)
+	return s.service.Audit(ctx, request)
 }
 
 func (s Server) GetReconcilerRequest(ctx context.Context, _ *v1alpha1.GetClusterStatusReconcilerRequest) (*v1alpha1.GetClusterStatusReconcilerResponse, error) {
 	return s.service.ReconcilerStatus(ctx)
 }
+
+func (s Server) GetOperation(ctx context.Context, request *v1alpha1.GetOperationRequest) (*v1alpha1.Operation, error) {
+	return s.service.GetOperation(ctx, request.GetId())
+}
+
+func (s Server) ListOperations(ctx context.Context, _ *v1alpha1.ListOperationsRequest) (*v1alpha1.ListOperationsResponse, error) {
+	return s.service.ListOperations(ctx)
+}
+
+func (s Server) CancelOperation(ctx context.Context, request *v1alpha1.CancelOperationRequest) (*v1alpha1.Operation, error) {
+	return s.service.CancelOperation(ctx, request.GetId())
+}
+
+func (s Server) PlanCluster(ctx context.Context, request *v1alpha1.PlanClusterRequest) (*v1alpha1.PlanClusterResponse, error) {
+	return s.service.PlanCluster(ctx, request)
+}
+
+func (s Server) ValidateCluster(ctx context.Context, request *v1alpha1.ValidateClusterRequest) (*v1alpha1.ValidateClusterResponse, error) {
+	return s.service.ValidateCluster(ctx, request.GetSpec())
+}
+
+func (s Server) ExecuteScript(ctx context.Context, request *v1alpha1.ExecuteScriptRequest) (*v1alpha1.ExecuteScriptResponse, error) {
+	return s.service.ExecuteScript(ctx, request)
+}
+
+func (s Server) ListSpecRevisions(ctx context.Context, _ *v1alpha1.ListSpecRevisionsRequest) (*v1alpha1.ListSpecRevisionsResponse, error) {
+	return s.service.ListSpecRevisions(ctx)
+}
+
+func (s Server) RevertClusterSpec(ctx context.Context, request *v1alpha1.RevertClusterSpecRequest) (*v1alpha1.Cluster, error) {
+	return s.service.RevertClusterSpec(ctx, request.GetRevision())
+}
+
+func (s Server) ExportState(ctx context.Context, _ *v1alpha1.ExportStateRequest) (*v1alpha1.StateArchive, error) {
+	return s.service.ExportState(ctx)
+}
+
+func (s Server) ImportState(ctx context.Context, request *v1alpha1.ImportStateRequest) (*v1alpha1.ImportStateResponse, error) {
+	return s.service.ImportState(ctx, request.GetArchive())
+}
+
+func (s Server) ListAvailableVersions(ctx context.Context, request *v1alpha1.ListAvailableVersionsRequest) (*v1alpha1.ListAvailableVersionsResponse, error) {
+	return s.service.ListAvailableVersions(ctx, request.GetMinorVersion())
+}
+
+func (s Server) GetUpgradePaths(ctx context.Context, _ *v1alpha1.GetUpgradePathsRequest) (*v1alpha1.GetUpgradePathsResponse, error) {
+	return s.service.GetUpgradePaths(ctx)
+}
@@ -2,21 +2,36 @@ package agent
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/operations"
 	"kubeclusteragent/pkg/reconciler/certsreconciler"
 	"kubeclusteragent/pkg/tools/kubernetestoolsfactory"
 	"kubeclusteragent/pkg/tools/kubernetestoolsfactory/kubernetesproviders"
 	"kubeclusteragent/pkg/tools/metricstool"
 	"kubeclusteragent/pkg/tools/patchtool"
 	"kubeclusteragent/pkg/util/auth"
+	"kubeclusteragent/pkg/util/db"
+	errorutil "kubeclusteragent/pkg/util/error"
+	grpcutil "kubeclusteragent/pkg/util/grpc"
+	"kubeclusteragent/pkg/util/k8sversion"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
 	"kubeclusteragent/pkg/util/reconcile"
+	"kubeclusteragent/pkg/util/script"
+	"kubeclusteragent/pkg/util/secret"
+	"kubeclusteragent/pkg/util/validation"
+	"strings"
 	"time"
 
+	"go.uber.org/multierr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/reconciler/statusreconciler"
@@ -24,6 +39,7 @@ import (
 
 type Service interface {
 	GetCluster(ctx context.Context) (*v1alpha1.Cluster, error)
+	WatchCluster(ctx context.Context, revision int64, send func(*v1alpha1.WatchClusterEvent) error) error
 	CreateCluster(ctx context.Context, request *v1alpha1.CreateClusterRequest) (*v1alpha1.Cluster, error)
 	DeleteCluster(ctx context.Context) (*v1alpha1.Cluster, error)
 	GetKubeConfig(ctx context.Context) (*v1alpha1.Kubeconfig, error)
@@ -31,10 +47,22 @@ type Service interface {
 	GetCerts(ctx context.Context) (*v1alpha1.ClusterCertificatesResponse, error)
 	PatchCluster(ctx context.Context, request *v1alpha1.PatchClusterRequest) (*v1alpha1.Cluster, error)
 	UpgradeCluster(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) (*v1alpha1.Cluster, error)
-	Audit(ctx context.Context) (*v1alpha1.AuditHistoryResponse, error)
+	Audit(ctx context.Context, request *v1alpha1.AuditHistoryRequest) (*v1alpha1.AuditHistoryResponse, error)
 	ReconcilerStatus(ctx context.Context) (*v1alpha1.GetClusterStatusReconcilerResponse, error)
 	ReconcilerStop(ctx context.Context)
 	ReconcilerStart(ctx context.Context)
+	GetOperation(ctx context.Context, id string) (*v1alpha1.Operation, error)
+	ListOperations(ctx context.Context) (*v1alpha1.ListOperationsResponse, error)
+	CancelOperation(ctx context.Context, id string) (*v1alpha1.Operation, error)
+	PlanCluster(ctx context.Context, request *v1alpha1.PlanClusterRequest) (*v1alpha1.PlanClusterResponse, error)
+	ValidateCluster(ctx context.Context, spec *v1alpha1.ClusterSpec) (*v1alpha1.ValidateClusterResponse, error)
+	ExecuteScript(ctx context.Context, request *v1alpha1.ExecuteScriptRequest) (*v1alpha1.ExecuteScriptResponse, error)
+	ListSpecRevisions(ctx context.Context) (*v1alpha1.ListSpecRevisionsResponse, error)
+	RevertClusterSpec(ctx context.Context, revision int64) (*v1alpha1.Cluster, error)
+	ExportState(ctx context.Context) (*v1alpha1.StateArchive, error)
+	ImportState(ctx context.Context, archive []byte) (*v1alpha1.ImportStateResponse, error)
+	ListAvailableVersions(ctx context.Context, minorVersion string) (*v1alpha1.ListAvailableVersionsResponse, error)
+	GetUpgradePaths(ctx context.Context) (*v1alpha1.GetUpgradePathsResponse, error)
 }
 
 type LiveService struct {
@@ -43,19 +71,26 @@ type LiveService struct {
 	patchTool         patchtool.ClusterConfigurationChange
 	metricsTool       metricstool.PrometheusMetricsTool
 	ReconcileRegistry reconcile.ReconcilerRegistry
+	scripts           *script.Runner
+	versions          *k8sversion.Catalog
+	// stopping is closed when the agent stops, the watches end then
+	stopping <-chan struct{}
 }
 
 var _ Service = &LiveService{}
 var kubeToolFactory kubernetestoolsfactory.KubeToolsFactory = &kubernetestoolsfactory.KubeManager{}
 
 // NewLiveService Let the live service to decide which all k8s service need to be initialized
-func NewLiveService(ctx context.Context, patchTool patchtool.ClusterConfigurationChange, jwtManager auth.JWTManager, registry reconcile.ReconcilerRegistry) *LiveService {
+func NewLiveService(ctx context.Context, patchTool patchtool.ClusterConfigurationChange, jwtManager auth.JWTManager, registry reconcile.ReconcilerRegistry, scripts *script.Runner) *LiveService {
 	// Get Current cluster spec and check cluster type , default will be kubeadm tool
 	s := &LiveService{
 		InstallTool:       kubeToolFactory.GetKubernetesProviderOnStartup(ctx),
 		patchTool:         patchTool,
 		jwtManager:        jwtManager,
 		ReconcileRegistry: registry,
+		scripts:           scripts,
+		versions:          k8sversion.NewCatalog(linux.NewLiveExec()),
+		stopping:          ctx.Done(),
 	}
 	return s
 }
@@ -63,11 +98,45 @@ func NewLiveService(ctx context.Context, patchTool patchtool.ClusterConfiguratio
 func (s *LiveService) GetCluster(ctx context.Context) (*v1alpha1.Cluster, error) {
 	cluster, err := s.InstallTool.Cluster(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, errorutil.Status(err, codes.Internal)
 	}
+	setETag(ctx, cluster)
 	return cluster, nil
 }
 
+// WatchCluster sends the events of the cluster from the revision until the client goes away or the
+// agent stops. Without revision, a gateway client reconnecting to the event stream resumes from the
+// revision of its Last-Event-ID header.
+func (s *LiveService) WatchCluster(ctx context.Context, revision int64, send func(*v1alpha1.WatchClusterEvent) error) error {
+	if revision < 0 {
+		return status.Error(codes.InvalidArgument, "revision must not be negative")
+	}
+	if lastEventID, ok := grpcutil.LastEventID(ctx); ok && revision == 0 {
+		revision = lastEventID
+	}
+	clusterInfo := cluster.LiveStatus{}
+	watch := clusterInfo.WatchCluster(ctx, revision)
+	defer watch.Stop()
+	for {
+		select {
+		case event, ok := <-watch.Events():
+			if !ok {
+				if watch.Behind() {
+					return errorutil.New(codes.ResourceExhausted, constants.ReasonWatchBehind, "the watch fell behind the cluster events, watch again from the revision of the last received event").WithRetry()
+				}
+				return nil
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-s.stopping:
+			return errorutil.New(codes.Unavailable, constants.ReasonAgentStopping, "the agent is stopping, watch again from the revision of the last received event").WithRetry()
+		}
+	}
+}
+
 func (s *LiveService) CreateCluster(
 	ctx context.Context,
 	request *v1alpha1.CreateClusterRequest) (*v1alpha1.Cluster, error) {
@@ -77,13 +146,16 @@ func (s *LiveService) CreateCluster(
 	}
 
 	s.installToolGenerator(ctx, request.Spec)
-	if err := s.InstallTool.Install(ctx, request); err != nil {
-		return nil, status.Error(codes.AlreadyExists, err.Error())
+	operation, err := s.InstallTool.Install(ctx, request)
+	if err != nil {
+		return nil, errorutil.Status(err, codes.Internal)
 	}
 	cluster, err := s.InstallTool.Cluster(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, errorutil.Status(err, codes.Internal)
 	}
+	cluster.Operation = operation
+	setETag(ctx, cluster)
 	// register reconciler if not already done
 	go func() {
 		logger := log.From(ctx).WithName("service").WithName("create-cluster").WithName("RegisterReconciliations")
@@ -101,7 +173,7 @@ func (s *LiveService) CreateCluster(
 					return
 				}
 			}
-			if cl.Status.Phase == constants.ClusterPhaseFailed {
+			if cl.Status.Phase == constants.ClusterPhaseFailed || cl.Status.Phase == constants.ClusterPhaseCancelled {
 				return
 			}
 			if kc, err := s.InstallTool.Config(ctx); err == nil {
@@ -126,18 +198,24 @@ func (s *LiveService) CreateCluster(
 }
 
 func (s *LiveService) GetCerts(ctx context.Context) (*v1alpha1.ClusterCertificatesResponse, error) {
-	return s.InstallTool.GetCerts(ctx)
+	certs, err := s.InstallTool.GetCerts(ctx)
+	if err != nil {
+		return nil, errorutil.Status(err, codes.Internal)
+	}
+	return certs, nil
 }
 
 func (s *LiveService) DeleteCluster(ctx context.Context) (*v1alpha1.Cluster, error) {
-	if err := s.InstallTool.Reset(ctx); err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+	operation, err := s.InstallTool.Reset(ctx)
+	if err != nil {
+		return nil, errorutil.Status(err, codes.Internal)
 	}
 
 	cl, err := s.InstallTool.Cluster(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, errorutil.Status(err, codes.Internal)
 	}
+	cl.Operation = operation
 
 	// Unregister the status reconciler
 	go func() {
@@ -156,7 +234,7 @@ func (s *LiveService) DeleteCluster(ctx context.Context) (*v1alpha1.Cluster, err
 					return
 				}
 			}
-			if cl.Status.Phase == constants.ClusterPhaseFailed {
+			if cl.Status.Phase == constants.ClusterPhaseFailed || cl.Status.Phase == constants.ClusterPhaseCancelled {
 				return
 			}
 			s.ReconcileRegistry.UnRegister(statusreconciler.ClusterStatusReconcilerName)
@@ -168,31 +246,206 @@ func (s *LiveService) DeleteCluster(ctx context.Context) (*v1alpha1.Cluster, err
 }
 
 func (s *LiveService) UpgradeCluster(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) (*v1alpha1.Cluster, error) {
-	if err := s.InstallTool.Upgrade(ctx, request); err != nil {
-		return nil, status.Error(codes.AlreadyExists, err.Error())
+	resourceVersion, err := requestedResourceVersion(ctx, request.GetResourceVersion())
+	if err != nil {
+		return nil, err
+	}
+	request.ResourceVersion = resourceVersion
+	unredactSpec(ctx, request.GetSpec())
+	operation, err := s.InstallTool.Upgrade(ctx, request)
+	if err != nil {
+		return nil, errorutil.Status(err, codes.Internal)
 	}
 	clusterInfo, err := s.InstallTool.Cluster(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, errorutil.Status(err, codes.Internal)
 	}
+	clusterInfo.Operation = operation
+	setETag(ctx, clusterInfo)
 	return clusterInfo, nil
 }
 
 func (s *LiveService) PatchCluster(ctx context.Context, request *v1alpha1.PatchClusterRequest) (*v1alpha1.Cluster, error) {
-	if err := s.patchTool.Patch(ctx, request); err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+	resourceVersion, err := requestedResourceVersion(ctx, request.GetResourceVersion())
+	if err != nil {
+		return nil, err
+	}
+	request.ResourceVersion = resourceVersion
+	unredactSpec(ctx, request.GetSpec())
+	operation, err := s.patchTool.Patch(ctx, request)
+	if err != nil {
+		return nil, errorutil.Status(err, codes.Internal)
 	}
 	clusterInfo, err := s.InstallTool.Cluster(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, errorutil.Status(err, codes.Internal)
 	}
+	clusterInfo.Operation = operation
+	setETag(ctx, clusterInfo)
 	return clusterInfo, nil
 }
 
+func (s *LiveService) ListSpecRevisions(ctx context.Context) (*v1alpha1.ListSpecRevisionsResponse, error) {
+	revisions, err := cluster.ListSpecRevisions(ctx)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read spec revisions").WithRetry()
+	}
+	return &v1alpha1.ListSpecRevisionsResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+// RevertClusterSpec moves the cluster back to the spec of a revision. A revision with a newer Kubernetes
+// version is reverted by an upgrade, which only changes the version: the other changes of the revision
+// take a second revert once the upgrade completed. A revision with an older version is refused, the
+// cluster cannot be downgraded. Any other revision is reverted by a patch.
+func (s *LiveService) RevertClusterSpec(ctx context.Context, revision int64) (*v1alpha1.Cluster, error) {
+	if revision < 1 {
+		return nil, errorutil.New(codes.InvalidArgument, constants.ReasonInvalidRequest, "revision must be a positive number")
+	}
+	target, err := cluster.GetSpecRevision(ctx, revision)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read spec revision").WithRetry()
+	}
+	if target == nil {
+		return nil, errorutil.New(codes.NotFound, constants.ReasonRevisionNotFound, fmt.Sprintf("revision %d not found", revision))
+	}
+	current, err := s.InstallTool.Cluster(ctx)
+	if err != nil {
+		return nil, errorutil.Status(err, codes.Internal)
+	}
+	// the revert is decided against the current spec, it is aborted when the spec changes in between
+	resourceVersion, err := requestedResourceVersion(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	if resourceVersion == 0 {
+		resourceVersion = current.GetResourceVersion()
+	}
+	spec := target.GetSpec()
+	switch {
+	case spec == nil:
+		return nil, errorutil.New(codes.FailedPrecondition, constants.ReasonRevisionNotApplicable, fmt.Sprintf("revision %d has no cluster spec", revision))
+	case spec.GetClusterType() != current.GetSpec().GetClusterType():
+		return nil, errorutil.New(codes.FailedPrecondition, constants.ReasonRevisionNotApplicable,
+			fmt.Sprintf("revision %d is a %s cluster, the cluster is a %s cluster", revision, spec.GetClusterType(), current.GetSpec().GetClusterType()))
+	case proto.Equal(spec, current.GetSpec()):
+		return nil, errorutil.New(codes.FailedPrecondition, constants.ReasonSpecUnchanged, fmt.Sprintf("cluster spec already matches revision %d", revision))
+	case spec.GetVersion() != current.GetSpec().GetVersion():
+		if err := revertableVersion(revision, spec.GetVersion(), current.GetSpec().GetVersion()); err != nil {
+			return nil, err
+		}
+		log.From(ctx).WithName("service").WithName("revert-cluster-spec").Info("Reverting cluster spec by an upgrade", "revision", revision, "version", spec.GetVersion())
+		return s.UpgradeCluster(ctx, &v1alpha1.UpgradeClusterRequest{ApiVersion: current.GetApiVersion(), Kind: current.GetKind(), Spec: spec, ResourceVersion: resourceVersion})
+	}
+	log.From(ctx).WithName("service").WithName("revert-cluster-spec").Info("Reverting cluster spec by a patch", "revision", revision)
+	return s.PatchCluster(ctx, &v1alpha1.PatchClusterRequest{ApiVersion: current.GetApiVersion(), Kind: current.GetKind(), Spec: spec, ResourceVersion: resourceVersion})
+}
+
+// revertableVersion fails unless the Kubernetes version of the revision is newer than the cluster
+// version, kubeadm does not downgrade a cluster.
+func revertableVersion(revision int64, version, clusterVersion string) error {
+	target, err := k8sversion.Parse(version)
+	if err != nil {
+		return errorutil.Wrap(err, codes.FailedPrecondition, constants.ReasonRevisionNotApplicable, fmt.Sprintf("revision %d", revision))
+	}
+	current, err := k8sversion.Parse(clusterVersion)
+	if err != nil {
+		return errorutil.Wrap(err, codes.FailedPrecondition, constants.ReasonRevisionNotApplicable, "cluster version")
+	}
+	if target.Compare(current) < 0 {
+		return errorutil.New(codes.FailedPrecondition, constants.ReasonRevisionNotApplicable,
+			fmt.Sprintf("revision %d runs Kubernetes %s, older than the cluster version %s: a cluster cannot be downgraded", revision, version, clusterVersion))
+	}
+	return nil
+}
+
+// requestedResourceVersion returns the resource version of the cluster a mutation was made against,
+// the one of the request or, without it, the one of the If-Match header of a gateway request.
+func requestedResourceVersion(ctx context.Context, resourceVersion int64) (int64, error) {
+	if resourceVersion < 0 {
+		return 0, status.Error(codes.InvalidArgument, "resourceVersion must not be negative")
+	}
+	if resourceVersion > 0 {
+		return resourceVersion, nil
+	}
+	return grpcutil.IfMatch(ctx)
+}
+
+// setETag returns the resource version of the cluster as the ETag header of a gateway response.
+func setETag(ctx context.Context, clusterInfo *v1alpha1.Cluster) {
+	if err := grpcutil.SetETag(ctx, clusterInfo.GetResourceVersion()); err != nil {
+		log.From(ctx).WithName("service").Error(err, "unable to set the ETag header")
+	}
+}
+
+// unredactSpec gives the sensitive fields of a requested spec that a client sent back redacted, as it
+// read them from a response, their current value.
+func unredactSpec(ctx context.Context, spec *v1alpha1.ClusterSpec) {
+	if spec == nil {
+		return
+	}
+	clusterInfo := cluster.LiveStatus{}
+	secret.Unredact(spec, clusterInfo.GetSpec(ctx))
+}
+
+// ExportState returns the archive of the agent state.
+func (s *LiveService) ExportState(ctx context.Context) (*v1alpha1.StateArchive, error) {
+	archive, err := cluster.ExportState(ctx, nil)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read agent state").WithRetry()
+	}
+	data, err := archive.Marshal()
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.Internal, constants.ReasonStateExportFailed, "")
+	}
+	return &v1alpha1.StateArchive{
+		Archive:       data,
+		FormatVersion: int32(archive.FormatVersion),
+		SchemaVersion: int32(archive.SchemaVersion),
+		Checksum:      archive.Checksum,
+		CreateTime:    timestamppb.New(archive.CreateTime),
+	}, nil
+}
+
+// ImportState replaces the agent state with the state of the archive and records the import in the
+// audit history. The cluster tool is chosen again for the imported cluster type.
+func (s *LiveService) ImportState(ctx context.Context, data []byte) (*v1alpha1.ImportStateResponse, error) {
+	logger := log.From(ctx).WithName("service").WithName("import-state")
+	archive, err := db.ReadArchive(data)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.InvalidArgument, constants.ReasonInvalidArchive, "")
+	}
+	if err := cluster.ImportState(ctx, nil, archive); err != nil {
+		var conflict *cluster.ImportConflictError
+		switch {
+		case errors.As(err, &conflict):
+			inProgress := &operations.ConflictError{OperationID: conflict.Lock.OperationID, Operation: conflict.Lock.Operation}
+			return nil, errorutil.Wrap(inProgress, codes.Aborted, constants.ReasonOperationInProgress, "").
+				WithOperation(inProgress.OperationID, inProgress.Operation).WithRetry()
+		case errors.Is(err, cluster.ErrNewerArchive):
+			return nil, errorutil.Wrap(err, codes.FailedPrecondition, constants.ReasonUnsupportedArchive, "")
+		}
+		return nil, errorutil.Wrap(err, codes.Internal, constants.ReasonStateImportFailed, "import agent state")
+	}
+	s.InstallTool = kubeToolFactory.GetKubernetesProviderOnStartup(ctx)
+	clusterInfo := cluster.LiveStatus{}
+	spec := clusterInfo.GetSpec(ctx)
+	cluster.SetAuditLog(ctx, constants.OperationImport, spec.GetClusterType(), spec.GetVersion(), clusterInfo.GetStatus(ctx).GetPhase(),
+		fmt.Sprintf("Agent state imported from archive %s exported at %s", archive.Checksum, archive.CreateTime.Format(time.RFC3339)), "")
+	logger.Info("Agent state imported", "checksum", archive.Checksum, "schemaVersion", archive.SchemaVersion)
+	return &v1alpha1.ImportStateResponse{
+		SchemaVersion:         int32(archive.SchemaVersion),
+		MigratedSchemaVersion: int32(cluster.SchemaVersion),
+		Checksum:              archive.Checksum,
+		CreateTime:            timestamppb.New(archive.CreateTime),
+	}, nil
+}
+
 func (s *LiveService) GetKubeConfig(ctx context.Context) (*v1alpha1.Kubeconfig, error) {
 	config, err := s.InstallTool.Config(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, errorutil.Status(err, codes.Internal)
 	}
 	kubeconfig := &v1alpha1.Kubeconfig{
 		Contents: string(config),
@@ -203,7 +456,7 @@ func (s *LiveService) GetKubeConfig(ctx context.Context) (*v1alpha1.Kubeconfig,
 
 func (s *LiveService) ResetCerts(ctx context.Context) (*v1alpha1.ResetKubeconfigRequest, error) {
 	if err := s.InstallTool.ResetConfig(ctx); err != nil {
-		return nil, status.Error(codes.Unknown, err.Error())
+		return nil, errorutil.Status(err, codes.Internal)
 	}
 	_, err := s.GetKubeConfig(ctx)
 	// When kubeconfig changes, refresh the status reconciler
@@ -236,19 +489,199 @@ func (s *LiveService) ResetCerts(ctx context.Context) (*v1alpha1.ResetKubeconfig
 	return &v1alpha1.ResetKubeconfigRequest{}, err
 }
 
-func (s *LiveService) Audit(ctx context.Context) (*v1alpha1.AuditHistoryResponse, error) {
-	auditHistory, err := cluster.GetAuditLogs(ctx)
+// Audit returns a page of the audit history, oldest first, filtered by the request.
+func (s *LiveService) Audit(ctx context.Context, request *v1alpha1.AuditHistoryRequest) (*v1alpha1.AuditHistoryResponse, error) {
+	if request.GetPageSize() < 0 {
+		return nil, errorutil.New(codes.InvalidArgument, constants.ReasonInvalidRequest, "page size cannot be negative")
+	}
+	query := &cluster.AuditQuery{
+		Operation: request.GetOperation(),
+		Status:    request.GetStatus(),
+		PageSize:  int(request.GetPageSize()),
+		PageToken: request.GetPageToken(),
+	}
+	if request.GetStartTime() != nil {
+		query.From = request.GetStartTime().AsTime()
+	}
+	if request.GetEndTime() != nil {
+		query.To = request.GetEndTime().AsTime()
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return nil, errorutil.New(codes.InvalidArgument, constants.ReasonInvalidRequest, "start time must be before end time")
+	}
+	auditHistory, nextPageToken, err := cluster.GetAuditLogs(ctx, query)
+	if err != nil {
+		if errors.Is(err, cluster.ErrInvalidPageToken) {
+			return nil, errorutil.Wrap(err, codes.InvalidArgument, constants.ReasonInvalidRequest, "")
+		}
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read audit history").WithRetry()
+	}
+	return &v1alpha1.AuditHistoryResponse{
+		Operations:    auditHistory,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *LiveService) GetOperation(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	if id == "" {
+		return nil, errorutil.New(codes.InvalidArgument, constants.ReasonInvalidRequest, "operation id cannot be empty")
+	}
+	operation, err := cluster.GetOperation(ctx, id)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read operation").WithRetry()
+	}
+	if operation == nil {
+		return nil, errorutil.New(codes.NotFound, constants.ReasonOperationNotFound, fmt.Sprintf("operation %s not found", id))
+	}
+	return operation, nil
+}
+
+func (s *LiveService) ListOperations(ctx context.Context) (*v1alpha1.ListOperationsResponse, error) {
+	recorded, err := cluster.ListOperations(ctx)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read operations").WithRetry()
+	}
+	return &v1alpha1.ListOperationsResponse{
+		Operations: recorded,
+	}, nil
+}
+
+// CancelOperation requests the cancellation of an in-flight operation. The operation reaches the
+// Cancelled state once its executed tasks are rolled back, which happens after the call returns.
+func (s *LiveService) CancelOperation(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	operation, err := s.GetOperation(ctx, id)
 	if err != nil {
+		return nil, err
+	}
+	if err := operations.Cancel(id); err != nil {
+		if errors.Is(err, operations.ErrNotRunning) {
+			return nil, status.Errorf(codes.FailedPrecondition, "operation %s is %s, only in-flight operations can be cancelled", id, operation.GetState())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	if auditHistory == nil {
-		return nil, fmt.Errorf("audit history not found")
+	log.From(ctx).WithName("service").WithName("cancel-operation").Info("Operation cancellation requested", "id", id, "type", operation.GetType())
+	return operation, nil
+}
+
+// PlanCluster returns the tasks a cluster mutation would run and the changes each task would make
+// to the node, nothing is changed.
+func (s *LiveService) PlanCluster(ctx context.Context, request *v1alpha1.PlanClusterRequest) (*v1alpha1.PlanClusterResponse, error) {
+	var operation string
+	var clusterSpec *v1alpha1.ClusterSpec
+	var planned []*v1alpha1.PlannedTask
+	var err error
+	switch r := request.GetRequest().(type) {
+	case *v1alpha1.PlanClusterRequest_Create:
+		if err := s.createClusterRequestPreValidation(r.Create.GetSpec()); err != nil {
+			return nil, err
+		}
+		operation, clusterSpec = constants.OperationInstall, r.Create.GetSpec()
+		// the cluster type of the request decides the tool, as it does when the cluster is created
+		planned, err = kubeToolFactory.GetKubernetesToolByProvider(ctx, clusterSpec.ClusterType).Plan(ctx, operation, clusterSpec)
+	case *v1alpha1.PlanClusterRequest_Upgrade:
+		if r.Upgrade.GetSpec() == nil {
+			return nil, status.Error(codes.InvalidArgument, "request spec cannot be empty")
+		}
+		operation, clusterSpec = constants.OperationUpgrade, r.Upgrade.GetSpec()
+		planned, err = s.InstallTool.Plan(ctx, operation, clusterSpec)
+	case *v1alpha1.PlanClusterRequest_Patch:
+		if r.Patch.GetSpec() == nil {
+			return nil, status.Error(codes.InvalidArgument, "request spec cannot be empty")
+		}
+		operation, clusterSpec = constants.OperationPatch, r.Patch.GetSpec()
+		planned, err = s.patchTool.Plan(ctx, r.Patch)
+	case *v1alpha1.PlanClusterRequest_Delete:
+		current, clusterErr := s.InstallTool.Cluster(ctx)
+		if clusterErr != nil {
+			return nil, errorutil.Status(clusterErr, codes.Internal)
+		}
+		if current.GetStatus().GetPhase() == constants.ClusterPhaseNotInitialised {
+			return nil, status.Error(codes.FailedPrecondition, "cluster is not initialized, there is nothing to delete")
+		}
+		operation, clusterSpec = constants.OperationReset, current.GetSpec()
+		planned, err = s.InstallTool.Plan(ctx, operation, clusterSpec)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "one of create, upgrade, patch or delete must be set")
+	}
+	if err != nil {
+		return nil, planError(err)
 	}
-	return &v1alpha1.AuditHistoryResponse{
-		Operations: auditHistory,
+	return &v1alpha1.PlanClusterResponse{
+		Operation:   operation,
+		ClusterType: clusterSpec.GetClusterType(),
+		Version:     clusterSpec.GetVersion(),
+		Tasks:       planned,
 	}, nil
 }
 
+func planError(err error) error {
+	if errors.Is(err, errors.ErrUnsupported) {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return errorutil.Status(err, codes.InvalidArgument)
+}
+
+// ExecuteScript runs a script of the script directory with the request parameters and returns its
+// exit code and output. A script exiting with a non-zero code is not an error.
+func (s *LiveService) ExecuteScript(ctx context.Context, request *v1alpha1.ExecuteScriptRequest) (*v1alpha1.ExecuteScriptResponse, error) {
+	if s.scripts == nil {
+		return nil, status.Error(codes.FailedPrecondition, script.ErrDisabled.Error())
+	}
+	if request.GetScriptName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "script name cannot be empty")
+	}
+	timeout := constants.DefaultScriptTimeout
+	if request.GetTimeoutSeconds() != 0 {
+		timeout = time.Duration(request.GetTimeoutSeconds()) * time.Second
+	}
+	if timeout <= 0 || timeout > constants.MaxScriptTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "script timeout must be between 1s and %s", constants.MaxScriptTimeout)
+	}
+	result, err := s.scripts.Run(ctx, request.GetScriptPath(), request.GetScriptName(), nil, timeout, strings.Fields(request.GetParams())...)
+	if err != nil {
+		return nil, scriptError(err)
+	}
+	response, err := scriptResponse(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &v1alpha1.ExecuteScriptResponse{Response: response}, nil
+}
+
+func scriptError(err error) error {
+	switch {
+	case errors.Is(err, script.ErrDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, script.ErrNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, script.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return errorutil.Status(err, codes.Internal)
+}
+
+// scriptResponse returns the result of the script, an output larger than constants.MaxScriptOutput
+// is truncated. An output that is a JSON document is also returned parsed.
+func scriptResponse(result *script.Result) (*structpb.Value, error) {
+	output := result.Output
+	truncated := len(output) > constants.MaxScriptOutput
+	if truncated {
+		output = output[:constants.MaxScriptOutput]
+	}
+	fields := map[string]interface{}{
+		"script":          result.Script,
+		"exitCode":        result.ExitCode,
+		"output":          strings.ToValidUTF8(string(output), "\uFFFD"),
+		"truncated":       truncated,
+		"durationSeconds": result.Duration.Seconds(),
+	}
+	var parsed interface{}
+	if !truncated && json.Unmarshal(output, &parsed) == nil {
+		fields["result"] = parsed
+	}
+	return structpb.NewValue(fields)
+}
+
 func (s *LiveService) installToolGenerator(ctx context.Context, requestClusterSpec *v1alpha1.ClusterSpec) {
 	clusterInfo := cluster.LiveStatus{}
 	clusterStatus := clusterInfo.GetStatus(ctx)
@@ -259,21 +692,104 @@ func (s *LiveService) installToolGenerator(ctx context.Context, requestClusterSp
 	}
 }
 
+// ValidateCluster checks the spec of a cluster to create and returns it with the defaults the cluster
+// would be created with, nothing is changed.
+func (s *LiveService) ValidateCluster(ctx context.Context, spec *v1alpha1.ClusterSpec) (*v1alpha1.ValidateClusterResponse, error) {
+	if spec != nil {
+		spec = proto.Clone(spec).(*v1alpha1.ClusterSpec)
+	}
+	if err := s.createClusterRequestPreValidation(spec); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.ValidateClusterResponse{Spec: spec}, nil
+}
+
+// ListAvailableVersions returns the Kubernetes versions the node can install. The pkgs.k8s.io
+// repository of the minor version is read along with the repositories configured on the node.
+func (s *LiveService) ListAvailableVersions(ctx context.Context, minorVersion string) (*v1alpha1.ListAvailableVersionsResponse, error) {
+	var minors []string
+	if minorVersion != "" {
+		version, err := k8sversion.Parse(minorVersion + ".0")
+		if err != nil {
+			return nil, validation.NewError("minorVersion", fmt.Sprintf("%q is not a minor version like v1.30", minorVersion))
+		}
+		minors = append(minors, version.MinorVersion())
+	}
+	versions, err := s.versions.List(ctx, minors...)
+	return &v1alpha1.ListAvailableVersionsResponse{
+		Versions: availableVersions(versions),
+		Warnings: catalogWarnings(ctx, err),
+	}, nil
+}
+
+// GetUpgradePaths returns the versions the cluster can be upgraded to in one step. The pkgs.k8s.io
+// repositories of the current and the next minor versions are read along with the configured ones,
+// the repository of the node only has the versions of the minor version it was installed with.
+func (s *LiveService) GetUpgradePaths(ctx context.Context) (*v1alpha1.GetUpgradePathsResponse, error) {
+	clusterInfo, err := s.InstallTool.Cluster(ctx)
+	if err != nil {
+		return nil, errorutil.Status(err, codes.Internal)
+	}
+	if clusterInfo.GetStatus().GetPhase() == constants.ClusterPhaseNotInitialised {
+		return nil, errorutil.New(codes.FailedPrecondition, constants.ReasonClusterNotInitialized, "cluster is not initialized, there is nothing to upgrade")
+	}
+	if clusterInfo.GetSpec().GetClusterType() == constants.ClusterTypeK3s {
+		return nil, status.Error(codes.Unimplemented, "upgrade paths are only listed for kubeadm clusters, k3s clusters install the releases of the k3s channels")
+	}
+	current, err := k8sversion.Parse(clusterInfo.GetSpec().GetVersion())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster version: %v", err)
+	}
+	next := current
+	next.Minor++
+	versions, err := s.versions.List(ctx, current.MinorVersion(), next.MinorVersion())
+	return &v1alpha1.GetUpgradePathsResponse{
+		CurrentVersion: current.String(),
+		Targets:        availableVersions(k8sversion.UpgradeTargets(current, versions)),
+		Warnings:       catalogWarnings(ctx, err),
+	}, nil
+}
+
+func availableVersions(versions []k8sversion.Available) []*v1alpha1.AvailableVersion {
+	available := make([]*v1alpha1.AvailableVersion, 0, len(versions))
+	for _, v := range versions {
+		available = append(available, &v1alpha1.AvailableVersion{
+			Version:        v.Version.String(),
+			PackageVersion: v.PackageVersion,
+			Sources:        v.Sources,
+		})
+	}
+	return available
+}
+
+// catalogWarnings returns the errors of the version sources that could not be read.
+func catalogWarnings(ctx context.Context, err error) []string {
+	var warnings []string
+	for _, sourceErr := range multierr.Errors(err) {
+		log.From(ctx).WithName("service").Info("Version source could not be read", "reason", sourceErr.Error())
+		warnings = append(warnings, sourceErr.Error())
+	}
+	return warnings
+}
+
+// createClusterRequestPreValidation sets the defaults of the spec of a cluster to create and checks it,
+// an invalid spec is refused with its field violations before a tool is picked for its cluster type.
 func (s *LiveService) createClusterRequestPreValidation(clusterSpec *v1alpha1.ClusterSpec) error {
 	if clusterSpec == nil {
-		return status.Error(codes.InvalidArgument, "request spec cannot be empty")
-	}
-	if clusterSpec.ClusterType == "" {
-		return status.Error(codes.InvalidArgument, "cluster type cannot be empty")
+		return validation.NewError("spec", "must be set")
 	}
 	if clusterSpec.ClusterName == "" {
 		var hostUtil linux.Host = &linux.LiveHost{}
 		hostname, err := hostUtil.GetHostname()
-		if err != nil {
-			clusterSpec.ClusterName = "kubernetes"
+		if err != nil || hostname == "" {
+			hostname = "kubernetes"
 		}
 		clusterSpec.ClusterName = hostname
 	}
+	validation.SetDefaults(clusterSpec)
+	if err := validation.ValidateClusterSpec(clusterSpec); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,313 @@
+package agent
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/tools/kubernetestoolsfactory/kubernetesproviders"
+	"kubeclusteragent/pkg/tools/patchtool"
+	"kubeclusteragent/pkg/util/db"
+	errorutil "kubeclusteragent/pkg/util/error"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// useMemoryStore makes a migrated memory store the state store of the service.
+func useMemoryStore(t *testing.T) {
+	backend := db.NewMemoryBackend()
+	require.NoError(t, cluster.MigrateStore(context.Background(), backend))
+	db.SetDefault(backend)
+}
+
+// useClosedStore makes a closed store the state store of the service, every call to it fails.
+func useClosedStore(t *testing.T) {
+	backend, err := db.NewBoltBackend(filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, err)
+	require.NoError(t, backend.Close())
+	db.SetDefault(backend)
+}
+
+func TestLiveService_ErrorInfo(t *testing.T) {
+	ctx := context.Background()
+	s := &LiveService{}
+	tests := []struct {
+		name       string
+		store      func(t *testing.T)
+		call       func() error
+		wantCode   codes.Code
+		wantReason string
+		retryable  bool
+	}{
+		{
+			name: "GetOperation without id",
+			call: func() error {
+				_, err := s.GetOperation(ctx, "")
+				return err
+			},
+			wantCode:   codes.InvalidArgument,
+			wantReason: constants.ReasonInvalidRequest,
+		},
+		{
+			name: "GetOperation of an unknown operation",
+			call: func() error {
+				_, err := s.GetOperation(ctx, "operation-1")
+				return err
+			},
+			wantCode:   codes.NotFound,
+			wantReason: constants.ReasonOperationNotFound,
+		},
+		{
+			name:  "GetOperation with the store unavailable",
+			store: useClosedStore,
+			call: func() error {
+				_, err := s.GetOperation(ctx, "operation-1")
+				return err
+			},
+			wantCode:   codes.Unavailable,
+			wantReason: constants.ReasonStateStoreUnavailable,
+			retryable:  true,
+		},
+		{
+			name:  "ListOperations with the store unavailable",
+			store: useClosedStore,
+			call: func() error {
+				_, err := s.ListOperations(ctx)
+				return err
+			},
+			wantCode:   codes.Unavailable,
+			wantReason: constants.ReasonStateStoreUnavailable,
+			retryable:  true,
+		},
+		{
+			name: "Audit with a negative page size",
+			call: func() error {
+				_, err := s.Audit(ctx, &v1alpha1.AuditHistoryRequest{PageSize: -1})
+				return err
+			},
+			wantCode:   codes.InvalidArgument,
+			wantReason: constants.ReasonInvalidRequest,
+		},
+		{
+			name: "Audit with an unknown page token",
+			call: func() error {
+				_, err := s.Audit(ctx, &v1alpha1.AuditHistoryRequest{PageToken: "unknown"})
+				return err
+			},
+			wantCode:   codes.InvalidArgument,
+			wantReason: constants.ReasonInvalidRequest,
+		},
+		{
+			name:  "Audit with the store unavailable",
+			store: useClosedStore,
+			call: func() error {
+				_, err := s.Audit(ctx, &v1alpha1.AuditHistoryRequest{})
+				return err
+			},
+			wantCode:   codes.Unavailable,
+			wantReason: constants.ReasonStateStoreUnavailable,
+			retryable:  true,
+		},
+		{
+			name:  "ListSpecRevisions with the store unavailable",
+			store: useClosedStore,
+			call: func() error {
+				_, err := s.ListSpecRevisions(ctx)
+				return err
+			},
+			wantCode:   codes.Unavailable,
+			wantReason: constants.ReasonStateStoreUnavailable,
+			retryable:  true,
+		},
+		{
+			name: "RevertClusterSpec to an invalid revision",
+			call: func() error {
+				_, err := s.RevertClusterSpec(ctx, 0)
+				return err
+			},
+			wantCode:   codes.InvalidArgument,
+			wantReason: constants.ReasonInvalidRequest,
+		},
+		{
+			name: "RevertClusterSpec to an unknown revision",
+			call: func() error {
+				_, err := s.RevertClusterSpec(ctx, 42)
+				return err
+			},
+			wantCode:   codes.NotFound,
+			wantReason: constants.ReasonRevisionNotFound,
+		},
+		{
+			name:  "ExportState with the store unavailable",
+			store: useClosedStore,
+			call: func() error {
+				_, err := s.ExportState(ctx)
+				return err
+			},
+			wantCode:   codes.Unavailable,
+			wantReason: constants.ReasonStateStoreUnavailable,
+			retryable:  true,
+		},
+		{
+			name: "ImportState of an invalid archive",
+			call: func() error {
+				_, err := s.ImportState(ctx, []byte("not an archive"))
+				return err
+			},
+			wantCode:   codes.InvalidArgument,
+			wantReason: constants.ReasonInvalidArchive,
+		},
+		{
+			name: "ImportState of an archive of a newer agent",
+			call: func() error {
+				archive, err := cluster.ExportState(ctx, nil)
+				require.NoError(t, err)
+				archive.SchemaVersion = cluster.SchemaVersion + 1
+				data, err := archive.Marshal()
+				require.NoError(t, err)
+				_, err = s.ImportState(ctx, data)
+				return err
+			},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: constants.ReasonUnsupportedArchive,
+		},
+		{
+			name: "ImportState during an operation",
+			call: func() error {
+				exported, err := s.ExportState(ctx)
+				require.NoError(t, err)
+				_, err = (&cluster.LiveStatus{}).AcquireOperationLock(ctx, &cluster.OperationLock{OperationID: "operation-1", Operation: constants.OperationPatch})
+				require.NoError(t, err)
+				_, err = s.ImportState(ctx, exported.GetArchive())
+				return err
+			},
+			wantCode:   codes.Aborted,
+			wantReason: constants.ReasonOperationInProgress,
+			retryable:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.store != nil {
+				tt.store(t)
+			} else {
+				useMemoryStore(t)
+			}
+			st := status.Convert(tt.call())
+			require.Equal(t, tt.wantCode, st.Code())
+			info := errorutil.ErrorInfo(st)
+			require.Equal(t, tt.wantReason, info.GetReason())
+			require.Equal(t, constants.ErrorDomain, info.GetDomain())
+			_, retry := errorutil.RetryDelay(st)
+			require.Equal(t, tt.retryable, retry)
+		})
+	}
+}
+
+// fakeProvider answers with the cluster and records the upgrades requested of it.
+type fakeProvider struct {
+	kubernetesproviders.KubernetesProviderFactory
+	cluster  *v1alpha1.Cluster
+	upgrades []*v1alpha1.UpgradeClusterRequest
+}
+
+func (f *fakeProvider) Cluster(ctx context.Context) (*v1alpha1.Cluster, error) {
+	return proto.Clone(f.cluster).(*v1alpha1.Cluster), nil
+}
+
+func (f *fakeProvider) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) (*v1alpha1.Operation, error) {
+	f.upgrades = append(f.upgrades, request)
+	return &v1alpha1.Operation{Type: constants.OperationUpgrade}, nil
+}
+
+// fakePatchTool records the patches requested of it.
+type fakePatchTool struct {
+	patchtool.ClusterConfigurationChange
+	patches []*v1alpha1.PatchClusterRequest
+}
+
+func (f *fakePatchTool) Patch(ctx context.Context, request *v1alpha1.PatchClusterRequest) (*v1alpha1.Operation, error) {
+	f.patches = append(f.patches, request)
+	return &v1alpha1.Operation{Type: constants.OperationPatch}, nil
+}
+
+func TestLiveService_RevertClusterSpec(t *testing.T) {
+	ctx := context.Background()
+	current := &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeKubeadm, Version: "v1.29.3", Networking: &v1alpha1.ClusterNetworking{CniName: "calico"}}
+	tests := []struct {
+		name        string
+		revision    *v1alpha1.ClusterSpec
+		wantCode    codes.Code
+		wantReason  string
+		wantUpgrade string
+		wantPatch   bool
+	}{
+		{
+			name:        "a newer version is reverted by an upgrade",
+			revision:    &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeKubeadm, Version: "v1.30.1"},
+			wantUpgrade: "v1.30.1",
+		},
+		{
+			name:       "an older version is refused",
+			revision:   &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeKubeadm, Version: "v1.28.9"},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: constants.ReasonRevisionNotApplicable,
+		},
+		{
+			name:      "the same version is reverted by a patch",
+			revision:  &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeKubeadm, Version: "v1.29.3", Networking: &v1alpha1.ClusterNetworking{CniName: "cilium"}},
+			wantPatch: true,
+		},
+		{
+			name:       "another cluster type is refused",
+			revision:   &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeK3s, Version: "v1.30.1"},
+			wantCode:   codes.FailedPrecondition,
+			wantReason: constants.ReasonRevisionNotApplicable,
+		},
+		{
+			name:       "the current spec is refused",
+			revision:   proto.Clone(current).(*v1alpha1.ClusterSpec),
+			wantCode:   codes.FailedPrecondition,
+			wantReason: constants.ReasonSpecUnchanged,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useMemoryStore(t)
+			recorded, err := (&cluster.LiveStatus{}).AddSpecRevision(ctx, &v1alpha1.SpecRevision{Spec: tt.revision})
+			require.NoError(t, err)
+			provider := &fakeProvider{cluster: &v1alpha1.Cluster{Spec: current, ResourceVersion: 3}}
+			patches := &fakePatchTool{}
+			s := &LiveService{InstallTool: provider, patchTool: patches}
+
+			_, err = s.RevertClusterSpec(ctx, recorded.GetRevision())
+			if tt.wantCode != codes.OK {
+				st := status.Convert(err)
+				require.Equal(t, tt.wantCode, st.Code())
+				require.Equal(t, tt.wantReason, errorutil.ErrorInfo(st).GetReason())
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantUpgrade != "" {
+				require.Len(t, provider.upgrades, 1)
+				require.Equal(t, tt.wantUpgrade, provider.upgrades[0].GetSpec().GetVersion())
+				require.Equal(t, int64(3), provider.upgrades[0].GetResourceVersion())
+			} else {
+				require.Empty(t, provider.upgrades)
+			}
+			if tt.wantPatch {
+				require.Len(t, patches.patches, 1)
+				require.Equal(t, "cilium", patches.patches[0].GetSpec().GetNetworking().GetCniName())
+				require.Equal(t, int64(3), patches.patches[0].GetResourceVersion())
+			} else {
+				require.Empty(t, patches.patches)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package cluster
+
+import (
+	"time"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+)
+
+// Checkpoint is the progress of an in-flight operation. It is saved after every completed task so that
+// an operation interrupted by an agent restart or a host reboot can be resumed or rolled back.
+type Checkpoint struct {
+	OperationID string                `json:"operationId"`
+	Operation   string                `json:"operation"`
+	ClusterSpec *v1alpha1.ClusterSpec `json:"clusterSpec"`
+	// LastCompletedTask is the index of the last completed task across pre-tasks, tasks and post-tasks,
+	// -1 when no task has completed yet.
+	LastCompletedTask int `json:"lastCompletedTask"`
+	// CompletedTasks holds the index of every completed task. Tasks running in parallel may complete
+	// out of order, tasks after LastCompletedTask may have completed too.
+	CompletedTasks []int `json:"completedTasks,omitempty"`
+	// Steps are the Kubernetes versions of the steps of an operation made of steps, like the minor
+	// versions of a chained upgrade.
+	Steps     []string  `json:"steps,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -2,7 +2,13 @@ package cluster
 
 import (
 	"context"
+	"errors"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/auth"
 	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/secret"
+	"sync"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
@@ -16,22 +22,117 @@ type AuditCluster interface {
 var auditStore Status = &LiveStatus{}
 
 func SetAuditLog(ctx context.Context, operation string, clusterType string, version string, status string, message string, reason string) {
+	SetAuditLogWithRollbacks(ctx, operation, clusterType, version, status, message, reason, nil)
+}
+
+// SetAuditLogWithRollbacks records an audit entry along with the rollback outcome of a failed operation.
+func SetAuditLogWithRollbacks(ctx context.Context, operation string, clusterType string, version string, status string, message string, reason string,
+	rollbacks []*v1alpha1.TaskRollback) {
 	logger := log.From(ctx).WithName("cluster-audit").WithName("generate-audit-history")
 	auditCondition := &v1alpha1.Operations{
 		Operation:      operation,
 		Status:         status,
-		Reason:         reason,
-		Message:        message,
+		Reason:         secret.RedactString(reason),
+		Message:        secret.RedactString(message),
 		LastExecuted:   timestamppb.Now(),
 		ClusterType:    clusterType,
 		CurrentVersion: version,
+		Rollbacks:      rollbacks,
+		OperationId:    operationIDFrom(ctx),
+		Caller:         auth.Caller(ctx),
 	}
 	err := auditStore.SetAuditHistory(ctx, auditCondition)
 	if err != nil {
 		logger.Error(err, "unable to set condition to audit history")
 	}
+	if publisher := getAuditPublisher(); publisher != nil {
+		if err := publisher.Publish(ctx, auditCondition); err != nil {
+			logger.Error(err, "unable to forward audit entry to the audit sinks")
+		}
+	}
+}
+
+type operationIDKey struct{}
+
+// WithOperationID returns a copy of ctx carrying the id of the operation the audit entries recorded
+// with it belong to.
+func WithOperationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, operationIDKey{}, id)
+}
+
+func operationIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(operationIDKey{}).(string)
+	return id
+}
+
+// AuditPublisher forwards the recorded audit entries off the node.
+type AuditPublisher interface {
+	Publish(ctx context.Context, entry *v1alpha1.Operations) error
+}
+
+var auditPublisher = struct {
+	sync.Mutex
+	publisher AuditPublisher
+}{}
+
+// SetAuditPublisher forwards every audit entry recorded from now on to the publisher, nil stops
+// forwarding them.
+func SetAuditPublisher(publisher AuditPublisher) {
+	auditPublisher.Lock()
+	defer auditPublisher.Unlock()
+	auditPublisher.publisher = publisher
+}
+
+func getAuditPublisher() AuditPublisher {
+	auditPublisher.Lock()
+	defer auditPublisher.Unlock()
+	return auditPublisher.publisher
+}
+
+// GetAuditLogs returns a page of the audit history matching the query, oldest first, along with the
+// token of the next page, empty on the last page.
+func GetAuditLogs(ctx context.Context, query *AuditQuery) ([]*v1alpha1.Operations, string, error) {
+	return auditStore.GetAuditHistory(ctx, query)
+}
+
+// ErrInvalidPageToken is returned for a page token that was not returned by a previous page.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// AuditQuery selects a page of the audit history, zero fields do not filter the entries.
+type AuditQuery struct {
+	Operation string
+	Status    string
+	// From and To bound the time the entries were recorded, From included and To excluded.
+	From time.Time
+	To   time.Time
+	// PageSize bounds the entries of the page, every matching entry is returned when 0.
+	PageSize int
+	// PageToken is the token returned along with the previous page.
+	PageToken string
+}
+
+func (q *AuditQuery) matches(entry *v1alpha1.Operations) bool {
+	return (q.Operation == "" || entry.GetOperation() == q.Operation) &&
+		(q.Status == "" || entry.GetStatus() == q.Status)
+}
+
+var auditRetention = struct {
+	sync.Mutex
+	count int
+	age   time.Duration
+}{count: constants.DefaultAuditRetentionCount}
+
+// SetAuditRetention bounds the audit history kept in the store. As entries are recorded, the oldest
+// entries beyond count and the entries older than age are removed, 0 disables a bound.
+func SetAuditRetention(count int, age time.Duration) {
+	auditRetention.Lock()
+	defer auditRetention.Unlock()
+	auditRetention.count = count
+	auditRetention.age = age
 }
 
-func GetAuditLogs(ctx context.Context) ([]*v1alpha1.Operations, error) {
-	return auditStore.GetAuditHistory(ctx)
+func auditRetentionBounds() (int, time.Duration) {
+	auditRetention.Lock()
+	defer auditRetention.Unlock()
+	return auditRetention.count, auditRetention.age
 }
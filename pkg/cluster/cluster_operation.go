@@ -0,0 +1,19 @@
+package cluster
+
+import (
+	"context"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+)
+
+var operationStore Status = &LiveStatus{}
+
+// GetOperation returns the operation with the given id, nil when no such operation was recorded.
+func GetOperation(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	return operationStore.GetOperation(ctx, id)
+}
+
+// ListOperations returns the recorded operations, most recent first.
+func ListOperations(ctx context.Context) ([]*v1alpha1.Operation, error) {
+	return operationStore.ListOperations(ctx)
+}
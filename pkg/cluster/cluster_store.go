@@ -2,196 +2,501 @@ package cluster
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	v1 "k8s.io/api/core/v1"
 	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/util/db"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 )
 
 type clusterStore interface {
 	WriteClusterSpec(ctx context.Context, clusterSpec *v1alpha1.ClusterSpec) error
 	ReadClusterSpec(ctx context.Context) (*v1alpha1.ClusterSpec, error)
+	ReadResourceVersion(ctx context.Context) (int64, error)
 	WriteClusterStatus(ctx context.Context, clusterStatus *v1alpha1.ClusterStatus) error
 	ReadClusterStatus(ctx context.Context) (*v1alpha1.ClusterStatus, error)
-	WriteAuditHistory(ctx context.Context, auditHistory []*v1alpha1.Operations) error
-	ReadAuditHistory(ctx context.Context) ([]*v1alpha1.Operations, error)
+	WriteAuditEntry(ctx context.Context, entry *v1alpha1.Operations) error
+	ReadAuditHistory(ctx context.Context, query *AuditQuery) ([]*v1alpha1.Operations, string, error)
 	PurgeAll(ctx context.Context) error
 	WriteConfigMap(ctx context.Context, configMap *v1.ConfigMap, name string) error
 	ReadConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error)
+	WriteCheckpoint(ctx context.Context, checkpoint *Checkpoint) error
+	ReadCheckpoint(ctx context.Context) (*Checkpoint, error)
+	DeleteCheckpoint(ctx context.Context) error
+	WriteOperation(ctx context.Context, operation *v1alpha1.Operation) error
+	ReadOperation(ctx context.Context, id string) (*v1alpha1.Operation, error)
+	ReadOperations(ctx context.Context) ([]*v1alpha1.Operation, error)
+	AcquireOperationLock(ctx context.Context, lock *OperationLock) (*OperationLock, error)
+	ReleaseOperationLock(ctx context.Context, operationID string) error
+	ReadOperationLock(ctx context.Context) (*OperationLock, error)
+	WriteSpecRevision(ctx context.Context, revision *v1alpha1.SpecRevision) (*v1alpha1.SpecRevision, error)
+	ReadSpecRevisions(ctx context.Context) ([]*v1alpha1.SpecRevision, error)
+	ReadSpecRevision(ctx context.Context, revision int64) (*v1alpha1.SpecRevision, error)
 }
 
+// liveStore keeps the cluster state in a backend, the default one of the state store when nil.
 type liveStore struct {
-	clusterStore db.Store
+	backend db.Backend
+}
+
+// table returns a table of the store.
+func (s *liveStore) table(name string) *db.Store {
+	return &db.Store{TableName: name, Backend: s.backend}
 }
 
 const (
 	clusterSpecKey         = "clusterSpec"
 	clusterStatusKey       = "clusterStatus"
 	clusterAuditHistoryKey = "clusterAudits"
-	NilStingInBoltDB       = "<nil>"
+	checkpointKey          = "checkpoint"
+	operationLockKey       = "lock"
+	resourceVersionKey     = "resourceVersion"
 )
 
+// PurgeAll removes the cluster spec and status. The resource version is kept, the spec of the next
+// cluster gets a version no client holds.
 func (s *liveStore) PurgeAll(ctx context.Context) error {
-	clusterStoreConnect := s.clusterStore.Connect(db.DBClusterTableName)
-	err := clusterStoreConnect.Set(clusterSpecKey, "")
+	clusterStoreConnect := s.table(db.DBClusterTableName)
+	err := clusterStoreConnect.Delete(clusterSpecKey)
 	if err != nil {
 		return err
 	}
-	clusterStoreConnect = s.clusterStore.Connect(db.DBClusterStatusTableName)
-	err = clusterStoreConnect.Set(clusterStatusKey, "")
+	clusterStoreConnect = s.table(db.DBClusterStatusTableName)
+	return clusterStoreConnect.Delete(clusterStatusKey)
+}
+
+func (s *liveStore) ReadClusterSpec(ctx context.Context) (*v1alpha1.ClusterSpec, error) {
+	stateStore := s.table(db.DBClusterTableName)
+	clusterSpec, err := db.Get[v1alpha1.ClusterSpec](stateStore, clusterSpecKey)
 	if err != nil {
-		return err
+		return nil, multierr.Append(fmt.Errorf("no cluster spec found"), err)
+	}
+	if clusterSpec == nil {
+		return &v1alpha1.ClusterSpec{}, nil
+	}
+	if err := openSpec(clusterSpec); err != nil {
+		return nil, err
 	}
-	err = clusterStoreConnect.Set(clusterSpecKey, "")
+	return clusterSpec, nil
+}
+
+func (s *liveStore) WriteClusterStatus(ctx context.Context, clusterStatus *v1alpha1.ClusterStatus) error {
+	stateStore := s.table(db.DBClusterStatusTableName)
+	return db.Put(stateStore, clusterStatusKey, clusterStatus)
+}
+
+// WriteAuditEntry stores the entry under a key of its own and removes the entries beyond the audit
+// retention, in a single transaction.
+func (s *liveStore) WriteAuditEntry(ctx context.Context, entry *v1alpha1.Operations) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
 	if err != nil {
-		return err
+		return fmt.Errorf("marshal audit entry to JSON: %w", err)
 	}
-	return err
+	count, age := auditRetentionBounds()
+	stateStore := s.table(db.DBClusterAuditHistoryTableName)
+	return stateStore.Transaction(func(tx db.Tx) error {
+		if err := putAuditEntry(tx, entry.GetLastExecuted().AsTime(), data); err != nil {
+			return err
+		}
+		return pruneAuditHistory(tx, count, age, time.Now())
+	})
 }
 
-func (s *liveStore) ReadClusterSpec(ctx context.Context) (*v1alpha1.ClusterSpec, error) {
-	clusterStoreConnect := s.clusterStore.Connect(db.DBClusterTableName)
-	clusterSpec := &v1alpha1.ClusterSpec{}
-	if clusterStoreConnect != nil {
-		data := clusterStoreConnect.Get(clusterSpecKey)
-		clusterSpecStr := fmt.Sprintf("%v", data)
-		if clusterSpecStr != NilStingInBoltDB {
-			if err := json.Unmarshal([]byte(clusterSpecStr), clusterSpec); err != nil {
-				return nil, multierr.Append(fmt.Errorf("no cluster spec found"), err)
-			}
+// ReadAuditHistory returns the entries matching the query in the order they were recorded, at most
+// PageSize of them, along with the token of the next page.
+func (s *liveStore) ReadAuditHistory(ctx context.Context, query *AuditQuery) ([]*v1alpha1.Operations, string, error) {
+	after := ""
+	if query.PageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(query.PageToken)
+		if _, ok := auditKeyTime(string(decoded)); err != nil || !ok {
+			return nil, "", ErrInvalidPageToken
 		}
+		after = string(decoded)
 	}
-	return clusterSpec, nil
+	stateStore := s.table(db.DBClusterAuditHistoryTableName)
+	entries, err := stateStore.Entries()
+	if err != nil {
+		return nil, "", err
+	}
+	auditHistory := make([]*v1alpha1.Operations, 0)
+	last := ""
+	for _, entry := range entries {
+		recorded, ok := auditKeyTime(entry.Key)
+		if !ok || entry.Key <= after || (!query.From.IsZero() && recorded.Before(query.From)) {
+			continue
+		}
+		if !query.To.IsZero() && !recorded.Before(query.To) {
+			break
+		}
+		audit := &v1alpha1.Operations{}
+		if err := json.Unmarshal(entry.Value, audit); err != nil {
+			return nil, "", multierr.Append(fmt.Errorf("no audit history found"), err)
+		}
+		if !query.matches(audit) {
+			continue
+		}
+		if query.PageSize > 0 && len(auditHistory) == query.PageSize {
+			return auditHistory, base64.RawURLEncoding.EncodeToString([]byte(last)), nil
+		}
+		auditHistory = append(auditHistory, audit)
+		last = entry.Key
+	}
+	return auditHistory, "", nil
 }
 
-func (s *liveStore) WriteClusterStatus(ctx context.Context, clusterStatus *v1alpha1.ClusterStatus) error {
+func (s *liveStore) ReadClusterStatus(ctx context.Context) (*v1alpha1.ClusterStatus, error) {
+	stateStore := s.table(db.DBClusterStatusTableName)
+	clusterStatus, err := db.Get[v1alpha1.ClusterStatus](stateStore, clusterStatusKey)
+	if err != nil {
+		return nil, multierr.Append(fmt.Errorf("no cluster status found"), err)
+	}
+	if clusterStatus == nil {
+		return &v1alpha1.ClusterStatus{
+			Phase: constants.ClusterPhaseNotInitialised,
+			Conditions: []*v1alpha1.Condition{
+				{Type: v1alpha1.ConditionType_ClusterReady, Status: "False"},
+				{Type: v1alpha1.ConditionType_NodeReady, Status: "False"},
+				{Type: v1alpha1.ConditionType_ControlPlaneReady, Status: "False"},
+			},
+		}, nil
+	}
+	return clusterStatus, nil
+}
 
-	stateStore := s.clusterStore.Connect(db.DBClusterStatusTableName)
-	if stateStore != nil {
-		data, err := json.MarshalIndent(clusterStatus, "", "  ")
-		if err != nil {
-			return fmt.Errorf("marshal state data to JSON: %w", err)
+// WriteClusterSpec stores the spec and, when it differs from the stored one, increases the resource
+// version of the cluster, in a single transaction.
+func (s *liveStore) WriteClusterSpec(ctx context.Context, clusterSpec *v1alpha1.ClusterSpec) error {
+	sealed, err := sealSpec(clusterSpec)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(sealed, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %s/%s: %w", db.DBClusterTableName, clusterSpecKey, err)
+	}
+	stateStore := s.table(db.DBClusterTableName)
+	return stateStore.Transaction(func(tx db.Tx) error {
+		var current *v1alpha1.ClusterSpec
+		if stored := tx.Get(db.DBClusterTableName, clusterSpecKey); stored != nil {
+			current = &v1alpha1.ClusterSpec{}
+			if err := json.Unmarshal(stored, current); err != nil {
+				return fmt.Errorf("decode %s/%s: %w", db.DBClusterTableName, clusterSpecKey, err)
+			}
+			if err := openSpec(current); err != nil {
+				return err
+			}
+		}
+		if err := tx.Put(db.DBClusterTableName, clusterSpecKey, data); err != nil {
+			return err
 		}
-		err = stateStore.Set(clusterStatusKey, string(data))
+		if proto.Equal(current, clusterSpec) {
+			return nil
+		}
+		version, err := readResourceVersion(tx)
 		if err != nil {
 			return err
 		}
-		return nil
+		return putResourceVersion(tx, version+1)
+	})
+}
+
+// ReadResourceVersion returns the resource version of the cluster, 0 before a spec was ever stored.
+func (s *liveStore) ReadResourceVersion(ctx context.Context) (int64, error) {
+	stateStore := s.table(db.DBClusterTableName)
+	var version int64
+	err := stateStore.View(func(tx db.Tx) error {
+		var err error
+		version, err = readResourceVersion(tx)
+		return err
+	})
+	return version, err
+}
+
+func (s *liveStore) WriteConfigMap(ctx context.Context, configMap *v1.ConfigMap, name string) error {
+	stateStore := s.table(db.DBClusterTableName)
+	return db.Put(stateStore, name, configMap)
+}
+
+func (s *liveStore) ReadConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error) {
+	stateStore := s.table(db.DBClusterTableName)
+	configMap, err := db.Get[v1.ConfigMap](stateStore, name)
+	if err != nil {
+		return nil, multierr.Append(fmt.Errorf("no config map %s found", name), err)
 	}
-	return fmt.Errorf("error occoured making connection with the data store")
+	if configMap == nil {
+		return nil, fmt.Errorf("no config map %s found", name)
+	}
+	return configMap, nil
 }
 
-func (s *liveStore) WriteAuditHistory(ctx context.Context, auditHistory []*v1alpha1.Operations) error {
-	auditHistory = sortAuditHistoryByTimestamp(auditHistory)
-	stateStore := s.clusterStore.Connect(db.DBClusterAuditHistoryTableName)
-	if stateStore != nil {
-		data, err := json.MarshalIndent(auditHistory, "", "  ")
-		if err != nil {
-			return fmt.Errorf("marshal state data to JSON: %w", err)
-		}
-		err = stateStore.Set(clusterAuditHistoryKey, string(data))
-		if err != nil {
-			return err
+func (s *liveStore) WriteCheckpoint(ctx context.Context, checkpoint *Checkpoint) error {
+	stateStore := s.table(db.DBOperationCheckpointTableName)
+	sealed := *checkpoint
+	var err error
+	if sealed.ClusterSpec, err = sealSpec(checkpoint.ClusterSpec); err != nil {
+		return err
+	}
+	return db.Put(stateStore, checkpointKey, &sealed)
+}
+
+func (s *liveStore) ReadCheckpoint(ctx context.Context) (*Checkpoint, error) {
+	stateStore := s.table(db.DBOperationCheckpointTableName)
+	checkpoint, err := db.Get[Checkpoint](stateStore, checkpointKey)
+	if err != nil {
+		return nil, multierr.Append(fmt.Errorf("no operation checkpoint found"), err)
+	}
+	if checkpoint != nil {
+		if err := openSpec(checkpoint.ClusterSpec); err != nil {
+			return nil, err
 		}
-		return nil
 	}
-	return fmt.Errorf("error occoured making connection with the data store")
+	return checkpoint, nil
+}
 
+func (s *liveStore) DeleteCheckpoint(ctx context.Context) error {
+	stateStore := s.table(db.DBOperationCheckpointTableName)
+	return stateStore.Delete(checkpointKey)
 }
 
-func (s *liveStore) ReadAuditHistory(ctx context.Context) ([]*v1alpha1.Operations, error) {
-	stateStore := s.clusterStore.Connect(db.DBClusterAuditHistoryTableName)
-	auditHistory := make([]*v1alpha1.Operations, 0)
-	if stateStore != nil {
-		data := stateStore.Get(clusterAuditHistoryKey)
-		stateDataStr := fmt.Sprintf("%v", data)
-		if stateDataStr != NilStingInBoltDB {
-			if err := json.Unmarshal([]byte(stateDataStr), &auditHistory); err != nil {
-				return nil, multierr.Append(fmt.Errorf("no audit history found"), err)
-			}
+func (s *liveStore) WriteOperation(ctx context.Context, operation *v1alpha1.Operation) error {
+	stateStore := s.table(db.DBOperationTableName)
+	return db.Put(stateStore, operation.Id, operation)
+}
+
+func (s *liveStore) ReadOperation(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	stateStore := s.table(db.DBOperationTableName)
+	operation, err := db.Get[v1alpha1.Operation](stateStore, id)
+	if err != nil {
+		return nil, multierr.Append(fmt.Errorf("no operation found"), err)
+	}
+	return operation, nil
+}
+
+func (s *liveStore) ReadOperations(ctx context.Context) ([]*v1alpha1.Operation, error) {
+	stateStore := s.table(db.DBOperationTableName)
+	data, err := stateStore.List()
+	if err != nil {
+		return nil, err
+	}
+	operations := make([]*v1alpha1.Operation, 0, len(data))
+	for _, operationStr := range data {
+		operation := &v1alpha1.Operation{}
+		if err := json.Unmarshal([]byte(operationStr), operation); err != nil {
+			return nil, multierr.Append(fmt.Errorf("no operation found"), err)
 		}
+		operations = append(operations, operation)
 	}
-	return auditHistory, nil
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[i].StartTime.AsTime().After(operations[j].StartTime.AsTime())
+	})
+	return operations, nil
 }
 
-func (s *liveStore) ReadClusterStatus(ctx context.Context) (*v1alpha1.ClusterStatus, error) {
-	stateStore := s.clusterStore.Connect(db.DBClusterStatusTableName)
-	clusterStatus := &v1alpha1.ClusterStatus{
-		Phase: constants.ClusterPhaseNotInitialised,
-		Conditions: []*v1alpha1.Condition{
-			{Type: v1alpha1.ConditionType_ClusterReady, Status: "False"},
-			{Type: v1alpha1.ConditionType_NodeReady, Status: "False"},
-			{Type: v1alpha1.ConditionType_ControlPlaneReady, Status: "False"},
-		},
-	}
-	if stateStore != nil {
-		data := stateStore.Get(clusterStatusKey)
-		stateDataStr := fmt.Sprintf("%v", data)
-		if stateDataStr != NilStingInBoltDB {
-			if err := json.Unmarshal([]byte(stateDataStr), clusterStatus); err != nil {
-				return nil, multierr.Append(fmt.Errorf("no cluster status found"), err)
+// AcquireOperationLock stores the lock unless another operation holds it, in a single transaction.
+// It returns the holder of the lock, the given lock when it was acquired.
+func (s *liveStore) AcquireOperationLock(ctx context.Context, lock *OperationLock) (*OperationLock, error) {
+	stateStore := s.table(db.DBOperationLockTableName)
+	holder := lock
+	err := stateStore.Update(operationLockKey, func(current []byte) ([]byte, error) {
+		if current != nil {
+			existing := &OperationLock{}
+			if err := json.Unmarshal(current, existing); err != nil {
+				return nil, multierr.Append(fmt.Errorf("no operation lock found"), err)
+			}
+			if existing.OperationID != lock.OperationID {
+				holder = existing
+				return current, nil
 			}
 		}
+		data, err := json.MarshalIndent(lock, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal operation lock to JSON: %w", err)
+		}
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return clusterStatus, nil
+	return holder, nil
 }
 
-func (s *liveStore) WriteClusterSpec(ctx context.Context, clusterSpec *v1alpha1.ClusterSpec) error {
-	stateStore := s.clusterStore.Connect(db.DBClusterTableName)
-	if stateStore != nil {
-		data, err := json.MarshalIndent(clusterSpec, "", "  ")
-		if err != nil {
-			return fmt.Errorf("marshal state data to JSON: %w", err)
+// ReleaseOperationLock removes the lock when it is held by the given operation.
+func (s *liveStore) ReleaseOperationLock(ctx context.Context, operationID string) error {
+	stateStore := s.table(db.DBOperationLockTableName)
+	return stateStore.Update(operationLockKey, func(current []byte) ([]byte, error) {
+		if current == nil {
+			return nil, nil
 		}
-		err = stateStore.Set(clusterSpecKey, string(data))
-		if err != nil {
-			return err
+		existing := &OperationLock{}
+		if err := json.Unmarshal(current, existing); err == nil && existing.OperationID != operationID {
+			return current, nil
 		}
-		return nil
+		return nil, nil
+	})
+}
+
+// ReadOperationLock returns the held lock, nil when no operation holds it.
+func (s *liveStore) ReadOperationLock(ctx context.Context) (*OperationLock, error) {
+	stateStore := s.table(db.DBOperationLockTableName)
+	lock, err := db.Get[OperationLock](stateStore, operationLockKey)
+	if err != nil {
+		return nil, multierr.Append(fmt.Errorf("no operation lock found"), err)
 	}
-	return fmt.Errorf("error occoured making connection with the data store")
+	return lock, nil
 }
 
-func (s *liveStore) WriteConfigMap(ctx context.Context, configMap *v1.ConfigMap, name string) error {
-	stateStore := s.clusterStore.Connect(db.DBClusterTableName)
-	if stateStore != nil {
-		data, err := json.MarshalIndent(configMap, "", "  ")
+// WriteSpecRevision stores the revision under the number following the last stored one, in a single
+// transaction. It returns a copy of the revision with its number set.
+func (s *liveStore) WriteSpecRevision(ctx context.Context, revision *v1alpha1.SpecRevision) (*v1alpha1.SpecRevision, error) {
+	stateStore := s.table(db.DBClusterSpecRevisionTableName)
+	stored := proto.Clone(revision).(*v1alpha1.SpecRevision)
+	record := proto.Clone(revision).(*v1alpha1.SpecRevision)
+	var err error
+	if record.Spec, err = sealSpec(revision.GetSpec()); err != nil {
+		return nil, err
+	}
+	_, err = stateStore.Append(func(sequence int64) ([]byte, error) {
+		stored.Revision = sequence
+		record.Revision = sequence
+		data, err := json.MarshalIndent(record, "", "  ")
 		if err != nil {
-			return fmt.Errorf("marshal state data to JSON: %w", err)
+			return nil, fmt.Errorf("marshal cluster spec revision to JSON: %w", err)
 		}
-		err = stateStore.Set(name, string(data))
-		if err != nil {
-			return err
+		return data, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func (s *liveStore) ReadSpecRevisions(ctx context.Context) ([]*v1alpha1.SpecRevision, error) {
+	stateStore := s.table(db.DBClusterSpecRevisionTableName)
+	data, err := stateStore.List()
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*v1alpha1.SpecRevision, 0, len(data))
+	// the keys are in revision order, the most recent revision is listed first
+	for i := len(data) - 1; i >= 0; i-- {
+		revision := &v1alpha1.SpecRevision{}
+		if err := json.Unmarshal([]byte(data[i]), revision); err != nil {
+			return nil, multierr.Append(fmt.Errorf("no cluster spec revision found"), err)
 		}
-		return nil
+		if err := openSpec(revision.Spec); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
 	}
-	return fmt.Errorf("error occoured making connection with the data store")
+	return revisions, nil
 }
 
-func (s *liveStore) ReadConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error) {
-	stateStore := s.clusterStore.Connect(db.DBClusterTableName)
-	if stateStore != nil {
-		k8sConfigMap := new(v1.ConfigMap)
-		configMap := stateStore.Get(name)
-		configMapStr := fmt.Sprintf("%v", configMap)
-		if configMapStr != NilStingInBoltDB {
-			if err := json.Unmarshal([]byte(configMapStr), k8sConfigMap); err != nil {
-				return nil, multierr.Append(fmt.Errorf("no cluster status found"), err)
+func (s *liveStore) ReadSpecRevision(ctx context.Context, revision int64) (*v1alpha1.SpecRevision, error) {
+	stateStore := s.table(db.DBClusterSpecRevisionTableName)
+	specRevision, err := db.Get[v1alpha1.SpecRevision](stateStore, db.SequenceKey(revision))
+	if err != nil {
+		return nil, multierr.Append(fmt.Errorf("no cluster spec revision found"), err)
+	}
+	if specRevision != nil {
+		if err := openSpec(specRevision.Spec); err != nil {
+			return nil, err
+		}
+	}
+	return specRevision, nil
+}
+
+func readResourceVersion(tx db.Tx) (int64, error) {
+	data := tx.Get(db.DBClusterTableName, resourceVersionKey)
+	if data == nil {
+		return 0, nil
+	}
+	var version int64
+	if err := json.Unmarshal(data, &version); err != nil {
+		return 0, fmt.Errorf("decode %s/%s: %w", db.DBClusterTableName, resourceVersionKey, err)
+	}
+	return version, nil
+}
+
+func putResourceVersion(tx db.Tx, version int64) error {
+	return tx.Put(db.DBClusterTableName, resourceVersionKey, []byte(strconv.FormatInt(version, 10)))
+}
+
+// auditKey returns the key of an audit entry recorded at the given time, n tells apart the entries
+// recorded at the same time. The keys sort in the order the entries were recorded.
+func auditKey(recorded time.Time, n int) string {
+	return fmt.Sprintf("%020d-%04d", recorded.UnixNano(), n)
+}
+
+// auditKeyTime returns the time the entry of an audit key was recorded, false for other keys.
+func auditKeyTime(key string) (time.Time, bool) {
+	nanos, n, found := strings.Cut(key, "-")
+	if !found || len(nanos) != 20 || len(n) != 4 {
+		return time.Time{}, false
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, unixNano), true
+}
+
+// putAuditEntry stores the entry under the first audit key of its time that is not taken.
+func putAuditEntry(tx db.Tx, recorded time.Time, data []byte) error {
+	for n := 0; ; n++ {
+		key := auditKey(recorded, n)
+		if tx.Get(db.DBClusterAuditHistoryTableName, key) == nil {
+			return tx.Put(db.DBClusterAuditHistoryTableName, key, data)
+		}
+	}
+}
+
+// pruneAuditHistory removes the oldest entries beyond count and the entries recorded before now minus
+// age, 0 disables a bound.
+func pruneAuditHistory(tx db.Tx, count int, age time.Duration, now time.Time) error {
+	var keys []string
+	err := tx.ForEach(db.DBClusterAuditHistoryTableName, func(key, value []byte) error {
+		if _, ok := auditKeyTime(string(key)); ok {
+			keys = append(keys, string(key))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	var expired []string
+	if count > 0 && len(keys) > count {
+		expired, keys = keys[:len(keys)-count], keys[len(keys)-count:]
+	}
+	if age > 0 {
+		cutoff := now.Add(-age)
+		for _, key := range keys {
+			if recorded, _ := auditKeyTime(key); !recorded.Before(cutoff) {
+				break
 			}
-			return k8sConfigMap, nil
+			expired = append(expired, key)
+		}
+	}
+	for _, key := range expired {
+		if err := tx.Delete(db.DBClusterAuditHistoryTableName, key); err != nil {
+			return err
 		}
 	}
-	return nil, fmt.Errorf("error occoured making connection with the data store")
+	return nil
 }
 
+// sortAuditHistoryByTimestamp sorts the entries in the order they were recorded.
 func sortAuditHistoryByTimestamp(audits []*v1alpha1.Operations) []*v1alpha1.Operations {
-	sort.Slice(audits, func(i, j int) bool {
-		return audits[i].LastExecuted.AsTime().Before(audits[i].LastExecuted.AsTime())
+	sort.SliceStable(audits, func(i, j int) bool {
+		return audits[i].GetLastExecuted().AsTime().Before(audits[j].GetLastExecuted().AsTime())
 	})
 	return audits
 }
@@ -0,0 +1,145 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kubeclusteragent/pkg/util/db"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/secret"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+)
+
+var fieldEncryption = struct {
+	sync.Mutex
+	envelope *secret.Envelope
+}{}
+
+// SetEncryption encrypts the sensitive fields of the cluster specs kept in the store with the envelope,
+// they are kept in plain text when nil.
+func SetEncryption(envelope *secret.Envelope) {
+	fieldEncryption.Lock()
+	defer fieldEncryption.Unlock()
+	fieldEncryption.envelope = envelope
+}
+
+func encryption() *secret.Envelope {
+	fieldEncryption.Lock()
+	defer fieldEncryption.Unlock()
+	return fieldEncryption.envelope
+}
+
+// sealSpec returns a copy of the spec with its sensitive fields encrypted, nil for a nil spec.
+func sealSpec(spec *v1alpha1.ClusterSpec) (*v1alpha1.ClusterSpec, error) {
+	if spec == nil {
+		return nil, nil
+	}
+	sealed := proto.Clone(spec).(*v1alpha1.ClusterSpec)
+	if err := secret.Seal(sealed, encryption()); err != nil {
+		return nil, fmt.Errorf("encrypt cluster spec: %w", err)
+	}
+	return sealed, nil
+}
+
+// openSpec decrypts the sensitive fields of the spec read from the store.
+func openSpec(spec *v1alpha1.ClusterSpec) error {
+	if spec == nil {
+		return nil
+	}
+	if err := secret.Open(spec, encryption()); err != nil {
+		return fmt.Errorf("decrypt cluster spec: %w", err)
+	}
+	return nil
+}
+
+// EncryptStore encrypts, with the primary key of the encryption, the sensitive fields of the cluster
+// specs kept in the backend, the default backend of the state store when nil, that are in plain text
+// or encrypted with an older key. It is how a rotated key reaches the existing records. Without
+// encryption, a store holding encrypted fields is refused as they could not be read.
+func EncryptStore(ctx context.Context, backend db.Backend) error {
+	stateStore := &db.Store{Backend: backend}
+	var sealed int
+	err := stateStore.Transaction(func(tx db.Tx) error {
+		var err error
+		sealed, err = sealRecords(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if sealed > 0 {
+		log.From(ctx).WithName("cluster-store").Info("Encrypted the sensitive fields of the stored cluster specs", "records", sealed)
+	}
+	return nil
+}
+
+// sealRecords encrypts the records of the transaction holding a cluster spec whose sensitive fields
+// are not encrypted with the primary key, it returns the number of records it changed.
+func sealRecords(tx db.Tx) (int, error) {
+	sealed := 0
+	seal := func(table, key string, record interface{}, spec func() *v1alpha1.ClusterSpec) error {
+		data := tx.Get(table, key)
+		if data == nil {
+			return nil
+		}
+		if err := json.Unmarshal(data, record); err != nil {
+			return fmt.Errorf("decode %s/%s: %w", table, key, err)
+		}
+		changed, err := sealRecordSpec(spec())
+		if err != nil || !changed {
+			return err
+		}
+		if data, err = json.MarshalIndent(record, "", "  "); err != nil {
+			return fmt.Errorf("encode %s/%s: %w", table, key, err)
+		}
+		sealed++
+		return tx.Put(table, key, data)
+	}
+	clusterSpec := &v1alpha1.ClusterSpec{}
+	if err := seal(db.DBClusterTableName, clusterSpecKey, clusterSpec, func() *v1alpha1.ClusterSpec { return clusterSpec }); err != nil {
+		return 0, err
+	}
+	checkpoint := &Checkpoint{}
+	if err := seal(db.DBOperationCheckpointTableName, checkpointKey, checkpoint, func() *v1alpha1.ClusterSpec { return checkpoint.ClusterSpec }); err != nil {
+		return 0, err
+	}
+	var revisionKeys []string
+	err := tx.ForEach(db.DBClusterSpecRevisionTableName, func(key, value []byte) error {
+		revisionKeys = append(revisionKeys, string(key))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range revisionKeys {
+		revision := &v1alpha1.SpecRevision{}
+		if err := seal(db.DBClusterSpecRevisionTableName, key, revision, func() *v1alpha1.ClusterSpec { return revision.Spec }); err != nil {
+			return 0, err
+		}
+	}
+	return sealed, nil
+}
+
+// sealRecordSpec encrypts the sensitive fields of a stored spec in place, it tells whether it changed any.
+func sealRecordSpec(spec *v1alpha1.ClusterSpec) (bool, error) {
+	if spec == nil {
+		return false, nil
+	}
+	envelope := encryption()
+	if envelope == nil {
+		if secret.HasEncrypted(spec) {
+			return false, fmt.Errorf("%w: configure the encryption key file the state was written with", secret.ErrNoKey)
+		}
+		return false, nil
+	}
+	if !secret.NeedsSealing(spec, envelope) {
+		return false, nil
+	}
+	if err := secret.Seal(spec, envelope); err != nil {
+		return false, fmt.Errorf("encrypt cluster spec: %w", err)
+	}
+	return true, nil
+}
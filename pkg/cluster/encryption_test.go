@@ -0,0 +1,85 @@
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/db"
+	"kubeclusteragent/pkg/util/secret"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEnvelope(t *testing.T, ids ...string) *secret.Envelope {
+	var lines []string
+	for _, id := range ids {
+		key := sha256.Sum256([]byte(id))
+		lines = append(lines, id+":"+base64.StdEncoding.EncodeToString(key[:]))
+	}
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0600))
+	provider, err := secret.NewFileKeyProvider(path)
+	require.NoError(t, err)
+	return secret.NewEnvelope(provider)
+}
+
+func TestEncryption(t *testing.T) {
+	ctx := context.Background()
+	defer SetEncryption(nil)
+	backend := db.NewMemoryBackend()
+	s, err := NewLiveStatusWithBackend(ctx, false, backend)
+	require.NoError(t, err)
+	stored := func(table, key string) string {
+		value, err := backend.Get(table, key)
+		require.NoError(t, err)
+		return string(value)
+	}
+	spec := &v1alpha1.ClusterSpec{
+		ClusterName: "edge",
+		ClusterRuntime: &v1alpha1.ClusterRuntime{ClusterCri: &v1alpha1.ContainerRuntimeInterface{
+			RegistryAuth: &v1alpha1.RegistryAuth{IsAuthRequired: true, Username: "robot", Password: "registry-password"},
+		}},
+	}
+
+	// a spec written before the encryption was configured is encrypted on start
+	s.SetSpec(ctx, spec)
+	require.Contains(t, stored(db.DBClusterTableName, clusterSpecKey), "registry-password")
+	SetEncryption(newTestEnvelope(t, "old"))
+	require.NoError(t, EncryptStore(ctx, backend))
+	require.NotContains(t, stored(db.DBClusterTableName, clusterSpecKey), "registry-password")
+	require.Equal(t, "registry-password", s.GetSpec(ctx).ClusterRuntime.ClusterCri.RegistryAuth.Password)
+
+	AcceptSpec(ctx, s, spec, &v1alpha1.Operation{Id: "operation-1", Type: constants.OperationPatch})
+	require.NoError(t, s.SetCheckpoint(ctx, &Checkpoint{OperationID: "operation-1", ClusterSpec: spec}))
+	require.NotContains(t, stored(db.DBClusterSpecRevisionTableName, db.SequenceKey(1)), "registry-password")
+	require.NotContains(t, stored(db.DBOperationCheckpointTableName, checkpointKey), "registry-password")
+	require.Equal(t, "registry-password", spec.ClusterRuntime.ClusterCri.RegistryAuth.Password, "the written spec is left untouched")
+
+	// rotation re-encrypts every record with the new primary key
+	SetEncryption(newTestEnvelope(t, "new", "old"))
+	require.NoError(t, EncryptStore(ctx, backend))
+	for _, record := range []string{
+		stored(db.DBClusterTableName, clusterSpecKey),
+		stored(db.DBClusterSpecRevisionTableName, db.SequenceKey(1)),
+		stored(db.DBOperationCheckpointTableName, checkpointKey),
+	} {
+		require.Contains(t, record, "enc:v1:new:")
+	}
+	revision, err := s.GetSpecRevision(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, "registry-password", revision.Spec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+	checkpoint, err := s.GetCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "registry-password", checkpoint.ClusterSpec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+
+	// encrypted records cannot be read without the key
+	SetEncryption(nil)
+	require.ErrorIs(t, EncryptStore(ctx, backend), secret.ErrNoKey)
+}
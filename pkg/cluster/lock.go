@@ -0,0 +1,12 @@
+package cluster
+
+import "time"
+
+// OperationLock is held by the operation mutating the cluster, at most one operation holds it at a time.
+// The lock is kept in the store, an operation interrupted by an agent restart keeps it until it is
+// resumed or rolled back.
+type OperationLock struct {
+	OperationID string    `json:"operationId"`
+	Operation   string    `json:"operation"`
+	AcquiredAt  time.Time `json:"acquiredAt"`
+}
@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kubeclusteragent/pkg/util/db"
+	"kubeclusteragent/pkg/util/log/log"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+)
+
+// migrations are the changes to the layout of the state store, in order. The schema version of the
+// store is the version of the last migration applied to it.
+var migrations = []db.Migration{
+	{
+		Version:     1,
+		Description: "remove the empty values left by purging the cluster data",
+		Migrate:     removeEmptyValues,
+	},
+	{
+		Version:     2,
+		Description: "store every audit entry under a key of its own",
+		Migrate:     splitAuditHistory,
+	},
+	{
+		Version:     3,
+		Description: "version the cluster spec",
+		Migrate:     versionClusterSpec,
+	},
+}
+
+// SchemaVersion is the version of the state store layout written by this agent.
+var SchemaVersion = len(migrations)
+
+// MigrateStore brings a state store written by an older agent to SchemaVersion, the default backend
+// of the state store when backend is nil. It must run before the store is read, a store written by
+// a newer agent is refused.
+func MigrateStore(ctx context.Context, backend db.Backend) error {
+	logger := log.From(ctx).WithName("cluster-store")
+	stateStore := &db.Store{TableName: db.DBMetadataTableName, Backend: backend}
+	from, to, err := stateStore.Migrate(migrations)
+	if err != nil {
+		return err
+	}
+	if from != to {
+		logger.Info("Migrated state store", "fromSchemaVersion", from, "toSchemaVersion", to)
+	}
+	return nil
+}
+
+// removeEmptyValues deletes the keys that older agents cleared by writing an empty value.
+func removeEmptyValues(tx db.Tx) error {
+	for _, table := range []string{db.DBClusterTableName, db.DBClusterStatusTableName} {
+		var keys []string
+		err := tx.ForEach(table, func(key, value []byte) error {
+			if len(value) == 0 {
+				keys = append(keys, string(key))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := tx.Delete(table, key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// splitAuditHistory moves the entries of the audit history, which older agents kept in a single value,
+// to keys of their own in the order they were recorded.
+func splitAuditHistory(tx db.Tx) error {
+	data := tx.Get(db.DBClusterAuditHistoryTableName, clusterAuditHistoryKey)
+	if data == nil {
+		return nil
+	}
+	var auditHistory []*v1alpha1.Operations
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &auditHistory); err != nil {
+			return fmt.Errorf("decode audit history: %w", err)
+		}
+	}
+	for _, entry := range sortAuditHistoryByTimestamp(auditHistory) {
+		entryData, err := json.MarshalIndent(entry, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal audit entry to JSON: %w", err)
+		}
+		if err := putAuditEntry(tx, entry.GetLastExecuted().AsTime(), entryData); err != nil {
+			return err
+		}
+	}
+	return tx.Delete(db.DBClusterAuditHistoryTableName, clusterAuditHistoryKey)
+}
+
+// versionClusterSpec sets the resource version of a cluster spec stored by older agents, which did not
+// version it, to 1.
+func versionClusterSpec(tx db.Tx) error {
+	if tx.Get(db.DBClusterTableName, clusterSpecKey) == nil || tx.Get(db.DBClusterTableName, resourceVersionKey) != nil {
+		return nil
+	}
+	return putResourceVersion(tx, 1)
+}
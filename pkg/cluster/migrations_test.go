@@ -0,0 +1,62 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/util/db"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMigrateStore_SplitAuditHistory(t *testing.T) {
+	ctx := context.Background()
+	backend := db.NewMemoryBackend()
+	recorded := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	// older agents kept the audit history in a single value, not always in order
+	auditHistory := []*v1alpha1.Operations{
+		{Operation: "Upgrade", LastExecuted: timestamppb.New(recorded.Add(time.Hour))},
+		{Operation: "Install", LastExecuted: timestamppb.New(recorded)},
+	}
+	data, err := json.Marshal(auditHistory)
+	require.NoError(t, err)
+	require.NoError(t, backend.Put(db.DBClusterAuditHistoryTableName, clusterAuditHistoryKey, data))
+
+	require.NoError(t, MigrateStore(ctx, backend))
+
+	old, err := backend.Get(db.DBClusterAuditHistoryTableName, clusterAuditHistoryKey)
+	require.NoError(t, err)
+	require.Nil(t, old)
+	s, err := NewLiveStatusWithBackend(ctx, false, backend)
+	require.NoError(t, err)
+	audits, _, err := s.GetAuditHistory(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, audits, 2)
+	require.Equal(t, "Install", audits[0].Operation)
+	require.Equal(t, "Upgrade", audits[1].Operation)
+
+	version, err := (&db.Store{TableName: db.DBMetadataTableName, Backend: backend}).SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, SchemaVersion, version)
+}
+
+func TestMigrateStore_VersionClusterSpec(t *testing.T) {
+	ctx := context.Background()
+	backend := db.NewMemoryBackend()
+	data, err := json.Marshal(&v1alpha1.ClusterSpec{ClusterName: "edge", Version: "v1.29.0"})
+	require.NoError(t, err)
+	require.NoError(t, backend.Put(db.DBClusterTableName, clusterSpecKey, data))
+	_, _, err = (&db.Store{TableName: db.DBMetadataTableName, Backend: backend}).Migrate(migrations[:2])
+	require.NoError(t, err)
+
+	require.NoError(t, MigrateStore(ctx, backend))
+	s, err := NewLiveStatusWithBackend(ctx, false, backend)
+	require.NoError(t, err)
+	resourceVersion, err := s.GetResourceVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resourceVersion)
+}
@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/pkg/constants"
+	errorutil "kubeclusteragent/pkg/util/error"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ResourceVersionConflictError is returned when a request was made against a resource version of the
+// cluster other than the current one, the cluster spec changed since the client read it.
+type ResourceVersionConflictError struct {
+	Expected int64
+	Current  int64
+}
+
+func (e *ResourceVersionConflictError) Error() string {
+	return fmt.Sprintf("the cluster is at resource version %d, not %d: read the cluster again and retry the request against its resource version", e.Current, e.Expected)
+}
+
+// GRPCStatus returns the conflict as Aborted, which the gateway returns as 409 Conflict. The request
+// is not retryable as is, it must be made again against the current resource version.
+func (e *ResourceVersionConflictError) GRPCStatus() *status.Status {
+	return errorutil.New(codes.Aborted, constants.ReasonResourceVersionConflict, e.Error()).GRPCStatus()
+}
+
+// CheckResourceVersion returns a *ResourceVersionConflictError when the cluster is not at the expected
+// resource version, 0 expects any version. The caller holds the operation lock, the version cannot
+// change until the spec of the request is accepted.
+func CheckResourceVersion(ctx context.Context, clusterStatus Status, expected int64) error {
+	if expected == 0 {
+		return nil
+	}
+	current, err := clusterStatus.GetResourceVersion(ctx)
+	if err != nil {
+		return errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read resource version").WithRetry()
+	}
+	if current != expected {
+		return &ResourceVersionConflictError{Expected: expected, Current: current}
+	}
+	return nil
+}
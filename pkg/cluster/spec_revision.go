@@ -0,0 +1,41 @@
+package cluster
+
+import (
+	"context"
+	"kubeclusteragent/pkg/util/auth"
+	"kubeclusteragent/pkg/util/log/log"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+)
+
+var revisionStore Status = &LiveStatus{}
+
+// AcceptSpec makes the spec accepted by the operation the cluster spec and records it as a new
+// revision, along with the caller of the request of ctx.
+func AcceptSpec(ctx context.Context, status Status, spec *v1alpha1.ClusterSpec, operation *v1alpha1.Operation) {
+	logger := log.From(ctx).WithName("cluster-store").WithName("accept-spec")
+	status.SetSpec(ctx, spec)
+	revision, err := status.AddSpecRevision(ctx, &v1alpha1.SpecRevision{
+		Spec:        spec,
+		CreateTime:  timestamppb.Now(),
+		Operation:   operation.GetType(),
+		OperationId: operation.GetId(),
+		Caller:      auth.Caller(ctx),
+	})
+	if err != nil {
+		logger.Error(err, "unable to record the cluster spec revision", "operation", operation.GetId())
+		return
+	}
+	logger.Info("Cluster spec revision recorded", "revision", revision.GetRevision(), "operation", operation.GetId())
+}
+
+// ListSpecRevisions returns the recorded cluster spec revisions, most recent first.
+func ListSpecRevisions(ctx context.Context) ([]*v1alpha1.SpecRevision, error) {
+	return revisionStore.ListSpecRevisions(ctx)
+}
+
+// GetSpecRevision returns the cluster spec revision with the given number, nil when no such revision was recorded.
+func GetSpecRevision(ctx context.Context, revision int64) (*v1alpha1.SpecRevision, error) {
+	return revisionStore.GetSpecRevision(ctx, revision)
+}
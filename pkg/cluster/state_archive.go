@@ -0,0 +1,109 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/db"
+	"kubeclusteragent/pkg/util/log/log"
+)
+
+// localTables belong to the agent running on the node rather than to the cluster, they are neither
+// exported nor replaced by an import.
+var localTables = []string{
+	db.DBOperationLockTableName,
+	db.DBOperationCheckpointTableName,
+	db.DBIdempotencyKeyTableName,
+}
+
+// ErrNewerArchive is returned when an archive was exported by an agent with a newer schema version.
+var ErrNewerArchive = errors.New("state archive was exported by a newer agent")
+
+// ImportConflictError is returned when an import is refused because an operation holds the operation lock.
+type ImportConflictError struct {
+	Lock *OperationLock
+}
+
+func (e *ImportConflictError) Error() string {
+	return fmt.Sprintf("%s operation %s is in progress, the state cannot be imported until it has completed", e.Lock.Operation, e.Lock.OperationID)
+}
+
+// ExportState returns the archive of the state kept in the backend, the default backend of the state
+// store when nil: the cluster spec and status, the audit history, the operations, the spec revisions
+// and the stored config maps.
+func ExportState(ctx context.Context, backend db.Backend) (*db.Archive, error) {
+	stateStore := &db.Store{Backend: backend}
+	var archive *db.Archive
+	err := stateStore.View(func(tx db.Tx) error {
+		var err error
+		archive, err = db.NewArchive(tx, localTables...)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export state: %w", err)
+	}
+	log.From(ctx).WithName("cluster-store").Info("Exported state", "schemaVersion", archive.SchemaVersion, "checksum", archive.Checksum)
+	return archive, nil
+}
+
+// ImportState replaces the state kept in the backend, the default backend of the state store when nil,
+// with the state of the archive migrated to SchemaVersion, in one transaction. An archive exported by a
+// newer agent is refused with ErrNewerArchive, an import while an operation holds the operation lock
+// with an *ImportConflictError. The sensitive fields of the imported specs are encrypted as the ones
+// written by this agent.
+func ImportState(ctx context.Context, backend db.Backend, archive *db.Archive) error {
+	if archive.SchemaVersion > SchemaVersion {
+		return fmt.Errorf("%w: schema version %d, this agent supports up to %d", ErrNewerArchive, archive.SchemaVersion, SchemaVersion)
+	}
+	stateStore := &db.Store{TableName: db.DBOperationLockTableName, Backend: backend}
+	var migratedFrom, migratedTo int
+	// the lock is checked in the transaction replacing the state, no operation can start in between
+	err := stateStore.Transaction(func(tx db.Tx) error {
+		if data := tx.Get(db.DBOperationLockTableName, operationLockKey); data != nil {
+			lock := &OperationLock{}
+			if err := json.Unmarshal(data, lock); err != nil {
+				return fmt.Errorf("decode operation lock: %w", err)
+			}
+			return &ImportConflictError{Lock: lock}
+		}
+		resourceVersion, err := readResourceVersion(tx)
+		if err != nil {
+			return err
+		}
+		if err := archive.Restore(tx, localTables...); err != nil {
+			return err
+		}
+		// the imported state is migrated along with the restore, a failing migration leaves the state
+		// as it was before the import
+		if migratedFrom, migratedTo, err = db.MigrateTx(tx, migrations); err != nil {
+			return err
+		}
+		// the imported spec gets a resource version above the ones of both states, no request made
+		// against either of them applies to it
+		imported, err := readResourceVersion(tx)
+		if err != nil {
+			return err
+		}
+		if err := putResourceVersion(tx, max(resourceVersion, imported)+1); err != nil {
+			return err
+		}
+		// the imported specs must be readable with the encryption of this agent, an archive with
+		// fields encrypted with a key this agent does not hold is refused
+		_, err = sealRecords(tx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	logger := log.From(ctx).WithName("cluster-store")
+	logger.Info("Imported state", "schemaVersion", archive.SchemaVersion, "checksum", archive.Checksum, "createTime", archive.CreateTime)
+	if migratedFrom != migratedTo {
+		logger.Info("Migrated imported state", "fromSchemaVersion", migratedFrom, "toSchemaVersion", migratedTo)
+	}
+	// the watches follow the imported cluster from there
+	imported := &LiveStatus{store: &liveStore{backend: backend}}
+	clusterWatches.publish(constants.WatchEventSync, imported.newCluster(ctx, imported.GetSpec(ctx), imported.GetStatus(ctx)))
+	return nil
+}
@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/db"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestExportImportState(t *testing.T) {
+	ctx := context.Background()
+	source := db.NewMemoryBackend()
+	require.NoError(t, MigrateStore(ctx, source))
+	exported, err := NewLiveStatusWithBackend(ctx, false, source)
+	require.NoError(t, err)
+	exported.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "edge", Version: "v1.29.0"})
+	exported.SetStatus(ctx, &v1alpha1.ClusterStatus{Phase: constants.ClusterPhaseProvisioned})
+	require.NoError(t, exported.SetAuditHistory(ctx, &v1alpha1.Operations{Operation: constants.OperationInstall, LastExecuted: timestamppb.New(time.Now())}))
+	require.NoError(t, exported.StoreConfigMap(ctx, &v1.ConfigMap{Data: map[string]string{"Corefile": ".:53"}}, "coredns"))
+	// an operation in progress on the exporting node does not follow the state
+	_, err = exported.AcquireOperationLock(ctx, &OperationLock{OperationID: "operation-1", Operation: constants.OperationPatch})
+	require.NoError(t, err)
+
+	archive, err := ExportState(ctx, source)
+	require.NoError(t, err)
+	require.Equal(t, SchemaVersion, archive.SchemaVersion)
+
+	target := db.NewMemoryBackend()
+	imported, err := NewLiveStatusWithBackend(ctx, false, target)
+	require.NoError(t, err)
+	imported.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "target", Version: "v1.28.0"})
+	imported.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "target", Version: "v1.29.0"})
+	require.NoError(t, ImportState(ctx, target, archive))
+	require.Equal(t, "edge", imported.GetSpec(ctx).ClusterName)
+	// no request made against either state applies to the imported spec
+	resourceVersion, err := imported.GetResourceVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resourceVersion)
+	require.Equal(t, constants.ClusterPhaseProvisioned, imported.GetStatus(ctx).Phase)
+	audits, _, err := imported.GetAuditHistory(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, audits, 1)
+	configMap, err := imported.GetConfigMap(ctx, "coredns")
+	require.NoError(t, err)
+	require.Equal(t, ".:53", configMap.Data["Corefile"])
+	lock, err := imported.GetOperationLock(ctx)
+	require.NoError(t, err)
+	require.Nil(t, lock)
+}
+
+func TestImportState_Refused(t *testing.T) {
+	ctx := context.Background()
+	archive, err := ExportState(ctx, db.NewMemoryBackend())
+	require.NoError(t, err)
+
+	t.Run("while an operation is in progress", func(t *testing.T) {
+		target := db.NewMemoryBackend()
+		s, err := NewLiveStatusWithBackend(ctx, false, target)
+		require.NoError(t, err)
+		s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "kept"})
+		_, err = s.AcquireOperationLock(ctx, &OperationLock{OperationID: "operation-1", Operation: constants.OperationUpgrade})
+		require.NoError(t, err)
+
+		var conflict *ImportConflictError
+		require.ErrorAs(t, ImportState(ctx, target, archive), &conflict)
+		require.Equal(t, "operation-1", conflict.Lock.OperationID)
+		require.Equal(t, "kept", s.GetSpec(ctx).ClusterName)
+	})
+
+	t.Run("from a newer agent", func(t *testing.T) {
+		newer := *archive
+		newer.SchemaVersion = SchemaVersion + 1
+		require.ErrorIs(t, ImportState(ctx, db.NewMemoryBackend(), &newer), ErrNewerArchive)
+	})
+
+	t.Run("when the migration fails", func(t *testing.T) {
+		target := db.NewMemoryBackend()
+		require.NoError(t, MigrateStore(ctx, target))
+		s, err := NewLiveStatusWithBackend(ctx, false, target)
+		require.NoError(t, err)
+		s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "kept"})
+		// the archive of an agent without schema version is migrated through the failing migration
+		released := migrations
+		t.Cleanup(func() { migrations = released })
+		migrations = append(append([]db.Migration{}, released[:len(released)-1]...), db.Migration{
+			Version:     SchemaVersion,
+			Description: "failing",
+			Migrate:     func(tx db.Tx) error { return errors.New("migration failed") },
+		})
+
+		require.ErrorContains(t, ImportState(ctx, target, archive), "migration failed")
+		// nothing of the archive was imported
+		require.Equal(t, "kept", s.GetSpec(ctx).ClusterName)
+		version, err := (&db.Store{Backend: target}).SchemaVersion()
+		require.NoError(t, err)
+		require.Equal(t, SchemaVersion, version)
+	})
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	v1 "k8s.io/api/core/v1"
 	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/db"
 	"kubeclusteragent/pkg/util/log/log"
 
 	"go.uber.org/multierr"
+	"google.golang.org/protobuf/proto"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 )
 
@@ -22,36 +24,66 @@ type Status interface {
 	ClusterSpec(ctx context.Context) *v1alpha1.ClusterSpec
 	SetSpec(ctx context.Context, spec *v1alpha1.ClusterSpec)
 	GetSpec(ctx context.Context) *v1alpha1.ClusterSpec
+	GetResourceVersion(ctx context.Context) (int64, error)
 	SetStatus(ctx context.Context, status *v1alpha1.ClusterStatus)
 	GetStatus(ctx context.Context) *v1alpha1.ClusterStatus
 	SetAuditHistory(ctx context.Context, currentOperation *v1alpha1.Operations) error
-	GetAuditHistory(ctx context.Context) ([]*v1alpha1.Operations, error)
+	GetAuditHistory(ctx context.Context, query *AuditQuery) ([]*v1alpha1.Operations, string, error)
 	PurgeAllClusterData(ctx context.Context) error
 	StoreConfigMap(ctx context.Context, configMap *v1.ConfigMap, name string) error
 	GetConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error)
+	SetCheckpoint(ctx context.Context, checkpoint *Checkpoint) error
+	GetCheckpoint(ctx context.Context) (*Checkpoint, error)
+	DeleteCheckpoint(ctx context.Context) error
+	SetOperation(ctx context.Context, operation *v1alpha1.Operation) error
+	GetOperation(ctx context.Context, id string) (*v1alpha1.Operation, error)
+	ListOperations(ctx context.Context) ([]*v1alpha1.Operation, error)
+	AcquireOperationLock(ctx context.Context, lock *OperationLock) (*OperationLock, error)
+	ReleaseOperationLock(ctx context.Context, operationID string) error
+	GetOperationLock(ctx context.Context) (*OperationLock, error)
+	AddSpecRevision(ctx context.Context, revision *v1alpha1.SpecRevision) (*v1alpha1.SpecRevision, error)
+	ListSpecRevisions(ctx context.Context) ([]*v1alpha1.SpecRevision, error)
+	GetSpecRevision(ctx context.Context, revision int64) (*v1alpha1.SpecRevision, error)
+	WatchCluster(ctx context.Context, revision int64) *ClusterWatch
 }
 
+// LiveStatus is the cluster state kept in the state store. The zero value uses the default
+// backend of the state store.
 type LiveStatus struct {
 	dryRun      bool
 	clusterSpec *v1alpha1.ClusterSpec
+	store       clusterStore
 }
 
 var _ Status = &LiveStatus{}
-var clusterInfo clusterStore = &liveStore{}
 
 func NewLiveStatus(ctx context.Context, dryRun bool) (*LiveStatus, error) {
+	return NewLiveStatusWithBackend(ctx, dryRun, nil)
+}
+
+// NewLiveStatusWithBackend returns the cluster state kept in the given backend, the default backend
+// of the state store when nil.
+func NewLiveStatusWithBackend(ctx context.Context, dryRun bool, backend db.Backend) (*LiveStatus, error) {
 	s := &LiveStatus{
 		dryRun:      dryRun,
 		clusterSpec: &v1alpha1.ClusterSpec{},
+		store:       &liveStore{backend: backend},
 	}
 	spec := s.GetSpec(ctx)
 	s.clusterSpec = spec
 	return s, nil
 }
 
+func (s *LiveStatus) clusterStore() clusterStore {
+	if s.store == nil {
+		return &liveStore{}
+	}
+	return s.store
+}
+
 func (s *LiveStatus) GetStatus(ctx context.Context) *v1alpha1.ClusterStatus {
 	logger := log.From(ctx).WithName("cluster-store").WithName("get-status")
-	clusterStatus, err := clusterInfo.ReadClusterStatus(ctx)
+	clusterStatus, err := s.clusterStore().ReadClusterStatus(ctx)
 	if err != nil {
 		logger.Error(err, "error occurred while getting status", "ClusterGetStatus", "failed")
 	}
@@ -65,15 +97,20 @@ func (s *LiveStatus) GetStatus(ctx context.Context) *v1alpha1.ClusterStatus {
 
 func (s *LiveStatus) SetStatus(ctx context.Context, status *v1alpha1.ClusterStatus) {
 	logger := log.From(ctx).WithName("cluster-store").WithName("set-status")
-	err := clusterInfo.WriteClusterStatus(ctx, status)
+	previous, _ := s.clusterStore().ReadClusterStatus(ctx)
+	err := s.clusterStore().WriteClusterStatus(ctx, status)
 	if err != nil {
 		logger.Error(err, "error occurred while saving the status", "ClusterWriteStatus", "failed")
+		return
+	}
+	if statusChanged(previous, status) {
+		clusterWatches.publish(constants.WatchEventStatusChanged, s.newCluster(ctx, s.GetSpec(ctx), proto.Clone(status).(*v1alpha1.ClusterStatus)))
 	}
 }
 
 func (s *LiveStatus) GetSpec(ctx context.Context) *v1alpha1.ClusterSpec {
 	logger := log.From(ctx).WithName("cluster-store").WithName("get-spec")
-	clusterSpec, err := clusterInfo.ReadClusterSpec(ctx)
+	clusterSpec, err := s.clusterStore().ReadClusterSpec(ctx)
 	if err != nil {
 		logger.Error(err, "error occurred while getting the cluster spec", "ClusterGetSpec", "failed")
 	}
@@ -92,20 +129,28 @@ func (s *LiveStatus) SetSpec(ctx context.Context, spec *v1alpha1.ClusterSpec) {
 	if spec == nil {
 		return
 	}
-	err := clusterInfo.WriteClusterSpec(ctx, spec)
+	previous, _ := s.clusterStore().ReadClusterSpec(ctx)
+	err := s.clusterStore().WriteClusterSpec(ctx, spec)
 	if err != nil {
 		logger.Error(err, "error occurred while saving the cluster spec", "ClusterSetSpec", "failed")
+		return
 	}
+	if !proto.Equal(previous, spec) {
+		clusterWatches.publish(constants.WatchEventSpecChanged, s.newCluster(ctx, proto.Clone(spec).(*v1alpha1.ClusterSpec), s.GetStatus(ctx)))
+	}
+}
+
+// GetResourceVersion returns the resource version of the cluster, increased every time its spec
+// changes. It is 0 until a spec is set.
+func (s *LiveStatus) GetResourceVersion(ctx context.Context) (int64, error) {
+	return s.clusterStore().ReadResourceVersion(ctx)
 }
 
+// SetAuditHistory records the entry in the audit history, the entries beyond the audit retention
+// are removed along the way.
 func (s *LiveStatus) SetAuditHistory(ctx context.Context, currentCondition *v1alpha1.Operations) error {
 	logger := log.From(ctx).WithName("cluster-store").WithName("set-audit-history")
-	audits, err := s.GetAuditHistory(ctx)
-	if err != nil {
-		logger.Error(err, "error occurred while setting the audit history of the cluster", "GetHistory", "failed")
-		return err
-	}
-	err = clusterInfo.WriteAuditHistory(ctx, append(audits, currentCondition))
+	err := s.clusterStore().WriteAuditEntry(ctx, currentCondition)
 	if err != nil {
 		logger.Error(err, "error occurred while setting the audit history of the cluster", "SetAuditHistory", "failed")
 		return err
@@ -114,25 +159,87 @@ func (s *LiveStatus) SetAuditHistory(ctx context.Context, currentCondition *v1al
 }
 
 func (s *LiveStatus) StoreConfigMap(ctx context.Context, configMap *v1.ConfigMap, name string) error {
-	return clusterInfo.WriteConfigMap(ctx, configMap, name)
+	return s.clusterStore().WriteConfigMap(ctx, configMap, name)
 }
 
 func (s *LiveStatus) GetConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error) {
-	return clusterInfo.ReadConfigMap(ctx, name)
+	return s.clusterStore().ReadConfigMap(ctx, name)
+}
+
+func (s *LiveStatus) SetCheckpoint(ctx context.Context, checkpoint *Checkpoint) error {
+	return s.clusterStore().WriteCheckpoint(ctx, checkpoint)
+}
+
+func (s *LiveStatus) GetCheckpoint(ctx context.Context) (*Checkpoint, error) {
+	return s.clusterStore().ReadCheckpoint(ctx)
+}
+
+func (s *LiveStatus) DeleteCheckpoint(ctx context.Context) error {
+	return s.clusterStore().DeleteCheckpoint(ctx)
 }
 
-func (s *LiveStatus) GetAuditHistory(ctx context.Context) ([]*v1alpha1.Operations, error) {
+func (s *LiveStatus) SetOperation(ctx context.Context, operation *v1alpha1.Operation) error {
+	return s.clusterStore().WriteOperation(ctx, operation)
+}
+
+// GetOperation returns the operation with the given id, nil when no such operation was recorded.
+func (s *LiveStatus) GetOperation(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	return s.clusterStore().ReadOperation(ctx, id)
+}
+
+func (s *LiveStatus) ListOperations(ctx context.Context) ([]*v1alpha1.Operation, error) {
+	return s.clusterStore().ReadOperations(ctx)
+}
+
+// AcquireOperationLock acquires the lock for the operation unless another operation holds it, it
+// returns the holder of the lock. Acquiring a lock already held by the operation succeeds.
+func (s *LiveStatus) AcquireOperationLock(ctx context.Context, lock *OperationLock) (*OperationLock, error) {
+	return s.clusterStore().AcquireOperationLock(ctx, lock)
+}
+
+// ReleaseOperationLock releases the lock when it is held by the given operation.
+func (s *LiveStatus) ReleaseOperationLock(ctx context.Context, operationID string) error {
+	return s.clusterStore().ReleaseOperationLock(ctx, operationID)
+}
+
+// GetOperationLock returns the held lock, nil when no operation holds it.
+func (s *LiveStatus) GetOperationLock(ctx context.Context) (*OperationLock, error) {
+	return s.clusterStore().ReadOperationLock(ctx)
+}
+
+// AddSpecRevision records the revision under the number following the last recorded one, it
+// returns the revision with its number set.
+func (s *LiveStatus) AddSpecRevision(ctx context.Context, revision *v1alpha1.SpecRevision) (*v1alpha1.SpecRevision, error) {
+	return s.clusterStore().WriteSpecRevision(ctx, revision)
+}
+
+// ListSpecRevisions returns the recorded cluster spec revisions, most recent first.
+func (s *LiveStatus) ListSpecRevisions(ctx context.Context) ([]*v1alpha1.SpecRevision, error) {
+	return s.clusterStore().ReadSpecRevisions(ctx)
+}
+
+// GetSpecRevision returns the cluster spec revision with the given number, nil when no such revision was recorded.
+func (s *LiveStatus) GetSpecRevision(ctx context.Context, revision int64) (*v1alpha1.SpecRevision, error) {
+	return s.clusterStore().ReadSpecRevision(ctx, revision)
+}
+
+// GetAuditHistory returns a page of the audit history matching the query, oldest first, along with
+// the token of the next page. A nil query returns the whole audit history.
+func (s *LiveStatus) GetAuditHistory(ctx context.Context, query *AuditQuery) ([]*v1alpha1.Operations, string, error) {
 	logger := log.From(ctx).WithName("cluster-store").WithName("get-audit-history")
-	auditHistory, err := clusterInfo.ReadAuditHistory(ctx)
+	if query == nil {
+		query = &AuditQuery{}
+	}
+	auditHistory, nextPageToken, err := s.clusterStore().ReadAuditHistory(ctx, query)
 	if err != nil {
 		logger.Error(err, "error occurred while getting the audit history of the cluster", "GetAuditHistory", "failed")
-		return nil, err
+		return nil, "", err
 	}
-	return auditHistory, nil
+	return auditHistory, nextPageToken, nil
 }
 
 func (s *LiveStatus) PurgeAllClusterData(ctx context.Context) error {
-	return clusterInfo.PurgeAll(ctx)
+	return s.clusterStore().PurgeAll(ctx)
 }
 
 type StateData struct {
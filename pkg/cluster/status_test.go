@@ -0,0 +1,248 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/db"
+	errorutil "kubeclusteragent/pkg/util/error"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestStatus(t *testing.T) *LiveStatus {
+	s, err := NewLiveStatusWithBackend(context.Background(), false, db.NewMemoryBackend())
+	require.NoError(t, err)
+	return s
+}
+
+func TestLiveStatus_Spec(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStatus(t)
+	require.True(t, proto.Equal(&v1alpha1.ClusterSpec{}, s.GetSpec(ctx)))
+	require.Equal(t, constants.ClusterPhaseNotInitialised, s.GetStatus(ctx).Phase)
+
+	spec := &v1alpha1.ClusterSpec{ClusterName: "test", Version: "v1.29.0"}
+	s.SetSpec(ctx, spec)
+	require.True(t, proto.Equal(spec, s.GetSpec(ctx)))
+	s.SetStatus(ctx, &v1alpha1.ClusterStatus{Phase: constants.ClusterPhaseProvisioned})
+	require.Equal(t, constants.ClusterPhaseProvisioned, s.GetStatus(ctx).Phase)
+
+	require.NoError(t, s.PurgeAllClusterData(ctx))
+	require.True(t, proto.Equal(&v1alpha1.ClusterSpec{}, s.GetSpec(ctx)))
+	require.Equal(t, constants.ClusterPhaseNotInitialised, s.GetStatus(ctx).Phase)
+}
+
+func TestLiveStatus_Checkpoint(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStatus(t)
+	checkpoint, err := s.GetCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+
+	require.NoError(t, s.SetCheckpoint(ctx, &Checkpoint{OperationID: "operation-1", Operation: constants.OperationInstall, LastCompletedTask: 2}))
+	checkpoint, err = s.GetCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "operation-1", checkpoint.OperationID)
+	require.Equal(t, 2, checkpoint.LastCompletedTask)
+
+	require.NoError(t, s.DeleteCheckpoint(ctx))
+	checkpoint, err = s.GetCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Nil(t, checkpoint)
+}
+
+func TestLiveStatus_OperationLock(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStatus(t)
+	first := &OperationLock{OperationID: "operation-1", Operation: constants.OperationInstall, AcquiredAt: time.Now().UTC()}
+	holder, err := s.AcquireOperationLock(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, first.OperationID, holder.OperationID)
+
+	holder, err = s.AcquireOperationLock(ctx, &OperationLock{OperationID: "operation-2", Operation: constants.OperationReset})
+	require.NoError(t, err)
+	require.Equal(t, first.OperationID, holder.OperationID)
+
+	require.NoError(t, s.ReleaseOperationLock(ctx, first.OperationID))
+	holder, err = s.GetOperationLock(ctx)
+	require.NoError(t, err)
+	require.Nil(t, holder)
+}
+
+func TestLiveStatus_Backends(t *testing.T) {
+	ctx := context.Background()
+	first, second := newTestStatus(t), newTestStatus(t)
+	first.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "first"})
+	require.Equal(t, "first", first.GetSpec(ctx).ClusterName)
+	require.Empty(t, second.GetSpec(ctx).ClusterName)
+}
+
+func TestLiveStatus_ResourceVersion(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStatus(t)
+	resourceVersion, err := s.GetResourceVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), resourceVersion)
+
+	s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "test", Version: "v1.28.0"})
+	s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "test", Version: "v1.28.0"})
+	resourceVersion, err = s.GetResourceVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), resourceVersion)
+	s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "test", Version: "v1.29.0"})
+	s.SetStatus(ctx, &v1alpha1.ClusterStatus{Phase: constants.ClusterPhaseProvisioned})
+	resourceVersion, err = s.GetResourceVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), resourceVersion)
+
+	require.NoError(t, CheckResourceVersion(ctx, s, 0))
+	require.NoError(t, CheckResourceVersion(ctx, s, 2))
+	err = CheckResourceVersion(ctx, s, 1)
+	var conflict *ResourceVersionConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, int64(1), conflict.Expected)
+	require.Equal(t, int64(2), conflict.Current)
+	st := conflict.GRPCStatus()
+	require.Equal(t, codes.Aborted, st.Code())
+	require.Equal(t, constants.ReasonResourceVersionConflict, errorutil.ErrorInfo(st).GetReason())
+	_, retryable := errorutil.RetryDelay(st)
+	require.False(t, retryable)
+
+	// the versions of a purged cluster are not reused by the next one
+	require.NoError(t, s.PurgeAllClusterData(ctx))
+	s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "test", Version: "v1.29.0"})
+	resourceVersion, err = s.GetResourceVersion(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resourceVersion)
+}
+
+func TestLiveStatus_SpecRevisions(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStatus(t)
+	revisions, err := s.ListSpecRevisions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+
+	installed := &v1alpha1.ClusterSpec{ClusterName: "test", Version: "v1.28.0"}
+	AcceptSpec(ctx, s, installed, &v1alpha1.Operation{Id: "operation-1", Type: constants.OperationInstall})
+	upgraded := &v1alpha1.ClusterSpec{ClusterName: "test", Version: "v1.29.0"}
+	AcceptSpec(ctx, s, upgraded, &v1alpha1.Operation{Id: "operation-2", Type: constants.OperationUpgrade})
+	require.True(t, proto.Equal(upgraded, s.GetSpec(ctx)))
+
+	revisions, err = s.ListSpecRevisions(ctx)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, int64(2), revisions[0].Revision)
+	require.Equal(t, "operation-2", revisions[0].OperationId)
+	require.Equal(t, constants.OperationUpgrade, revisions[0].Operation)
+	require.Equal(t, int64(1), revisions[1].Revision)
+	require.NotNil(t, revisions[1].CreateTime)
+
+	revision, err := s.GetSpecRevision(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, proto.Equal(installed, revision.Spec))
+	missing, err := s.GetSpecRevision(ctx, 3)
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	// the revisions outlive the cluster they belong to
+	require.NoError(t, s.PurgeAllClusterData(ctx))
+	revisions, err = s.ListSpecRevisions(ctx)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+}
+
+func TestLiveStatus_AuditHistory(t *testing.T) {
+	ctx := context.Background()
+	s := newTestStatus(t)
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	record := func(minutes int, operation, status string) {
+		require.NoError(t, s.SetAuditHistory(ctx, &v1alpha1.Operations{
+			Operation:    operation,
+			Status:       status,
+			LastExecuted: timestamppb.New(start.Add(time.Duration(minutes) * time.Minute)),
+		}))
+	}
+	// recorded out of order, two of them at the same time
+	record(2, constants.OperationUpgrade, constants.ClusterPhaseFailed)
+	record(0, constants.OperationInstall, constants.ClusterPhaseProvisioned)
+	record(3, constants.OperationUpgrade, constants.ClusterPhaseProvisioned)
+	record(3, constants.OperationPatch, constants.ClusterPhaseProvisioned)
+	record(1, constants.OperationUpgrade, constants.ClusterPhaseUpgrading)
+
+	operations := func(audits []*v1alpha1.Operations) []string {
+		var result []string
+		for _, audit := range audits {
+			result = append(result, audit.Operation+"@"+audit.LastExecuted.AsTime().Sub(start).String())
+		}
+		return result
+	}
+
+	t.Run("entries are returned in the order they were recorded", func(t *testing.T) {
+		audits, next, err := s.GetAuditHistory(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, next)
+		require.Equal(t, []string{"Install@0s", "Upgrade@1m0s", "Upgrade@2m0s", "Upgrade@3m0s", "Patch@3m0s"}, operations(audits))
+	})
+
+	t.Run("pages follow each other", func(t *testing.T) {
+		query := &AuditQuery{Operation: constants.OperationUpgrade, PageSize: 2}
+		page, next, err := s.GetAuditHistory(ctx, query)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Upgrade@1m0s", "Upgrade@2m0s"}, operations(page))
+		require.NotEmpty(t, next)
+		query.PageToken = next
+		page, next, err = s.GetAuditHistory(ctx, query)
+		require.NoError(t, err)
+		require.Equal(t, []string{"Upgrade@3m0s"}, operations(page))
+		require.Empty(t, next)
+	})
+
+	t.Run("entries are filtered by status and time range", func(t *testing.T) {
+		audits, _, err := s.GetAuditHistory(ctx, &AuditQuery{Status: constants.ClusterPhaseProvisioned, From: start.Add(time.Minute)})
+		require.NoError(t, err)
+		require.Equal(t, []string{"Upgrade@3m0s", "Patch@3m0s"}, operations(audits))
+		audits, _, err = s.GetAuditHistory(ctx, &AuditQuery{From: start.Add(time.Minute), To: start.Add(3 * time.Minute)})
+		require.NoError(t, err)
+		require.Equal(t, []string{"Upgrade@1m0s", "Upgrade@2m0s"}, operations(audits))
+	})
+
+	t.Run("a page token that was not returned is rejected", func(t *testing.T) {
+		_, _, err := s.GetAuditHistory(ctx, &AuditQuery{PageToken: "not-a-token"})
+		require.ErrorIs(t, err, ErrInvalidPageToken)
+	})
+}
+
+func TestLiveStatus_AuditRetention(t *testing.T) {
+	ctx := context.Background()
+	defer SetAuditRetention(constants.DefaultAuditRetentionCount, 0)
+	s := newTestStatus(t)
+	now := time.Now()
+	record := func(recorded time.Time, operation string) {
+		require.NoError(t, s.SetAuditHistory(ctx, &v1alpha1.Operations{Operation: operation, LastExecuted: timestamppb.New(recorded)}))
+	}
+
+	SetAuditRetention(2, 0)
+	record(now.Add(-3*time.Hour), "first")
+	record(now.Add(-2*time.Hour), "second")
+	record(now.Add(-time.Hour), "third")
+	audits, _, err := s.GetAuditHistory(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, audits, 2)
+	require.Equal(t, "second", audits[0].Operation)
+
+	SetAuditRetention(0, 90*time.Minute)
+	record(now, "fourth")
+	audits, _, err = s.GetAuditHistory(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, audits, 2)
+	require.Equal(t, "third", audits[0].Operation)
+	require.Equal(t, "fourth", audits[1].Operation)
+}
@@ -0,0 +1,166 @@
+package cluster
+
+import (
+	"context"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/log/log"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+)
+
+const (
+	// watchHistorySize bounds the events kept for the watches resuming from a revision.
+	watchHistorySize = 256
+	// watchBufferSize bounds the events waiting to be received by a watch, a watch falling further
+	// behind is ended.
+	watchBufferSize = 64
+)
+
+// ClusterWatch follows the changes of the cluster.
+type ClusterWatch struct {
+	events chan *v1alpha1.WatchClusterEvent
+	// behind and stopped are guarded by the lock of the hub
+	behind  bool
+	stopped bool
+	hub     *watchHub
+}
+
+// Events returns the events of the watch in revision order, the channel is closed once the watch is
+// stopped or fell behind.
+func (w *ClusterWatch) Events() <-chan *v1alpha1.WatchClusterEvent {
+	return w.events
+}
+
+// Behind tells whether the watch was ended because its events were not received fast enough, the
+// changes can be followed again from the revision of the last received event.
+func (w *ClusterWatch) Behind() bool {
+	w.hub.Lock()
+	defer w.hub.Unlock()
+	return w.behind
+}
+
+// Stop ends the watch.
+func (w *ClusterWatch) Stop() {
+	w.hub.Lock()
+	defer w.hub.Unlock()
+	w.hub.remove(w)
+}
+
+// watchHub hands the cluster events to the watches, it is shared by every LiveStatus as any of them
+// may change the cluster.
+type watchHub struct {
+	sync.Mutex
+	// revision is the revision of the last event
+	revision int64
+	// history holds the last events, in revision order
+	history []*v1alpha1.WatchClusterEvent
+	watches map[*ClusterWatch]struct{}
+}
+
+var clusterWatches = newWatchHub()
+
+func newWatchHub() *watchHub {
+	return &watchHub{watches: make(map[*ClusterWatch]struct{})}
+}
+
+// watch starts a watch from the revision. The events that followed the revision come first when they
+// are all kept, otherwise the watch starts with a Sync event of the cluster returned by current.
+func (h *watchHub) watch(revision int64, current func() *v1alpha1.Cluster) *ClusterWatch {
+	h.Lock()
+	defer h.Unlock()
+	var backlog []*v1alpha1.WatchClusterEvent
+	if revision > 0 && revision <= h.revision && h.revision-revision <= int64(len(h.history)) {
+		backlog = h.history[len(h.history)-int(h.revision-revision):]
+	} else {
+		backlog = []*v1alpha1.WatchClusterEvent{{
+			Revision: h.revision,
+			Type:     constants.WatchEventSync,
+			Cluster:  current(),
+			Time:     timestamppb.Now(),
+		}}
+	}
+	w := &ClusterWatch{
+		events: make(chan *v1alpha1.WatchClusterEvent, len(backlog)+watchBufferSize),
+		hub:    h,
+	}
+	for _, event := range backlog {
+		w.events <- event
+	}
+	h.watches[w] = struct{}{}
+	return w
+}
+
+// publish records the event under the next revision and hands it to the watches.
+func (h *watchHub) publish(eventType string, cluster *v1alpha1.Cluster) {
+	h.Lock()
+	defer h.Unlock()
+	h.revision++
+	event := &v1alpha1.WatchClusterEvent{
+		Revision: h.revision,
+		Type:     eventType,
+		Cluster:  cluster,
+		Time:     timestamppb.Now(),
+	}
+	h.history = append(h.history, event)
+	if len(h.history) > watchHistorySize {
+		h.history = h.history[len(h.history)-watchHistorySize:]
+	}
+	for w := range h.watches {
+		select {
+		case w.events <- event:
+		default:
+			w.behind = true
+			h.remove(w)
+		}
+	}
+}
+
+// remove ends the watch, the lock of the hub is held by the caller.
+func (h *watchHub) remove(w *ClusterWatch) {
+	if w.stopped {
+		return
+	}
+	w.stopped = true
+	delete(h.watches, w)
+	close(w.events)
+}
+
+// WatchCluster follows the changes of the cluster from the revision, see WatchClusterRequest. The
+// watch is stopped by the caller.
+func (s *LiveStatus) WatchCluster(ctx context.Context, revision int64) *ClusterWatch {
+	return clusterWatches.watch(revision, func() *v1alpha1.Cluster {
+		return s.newCluster(ctx, s.GetSpec(ctx), s.GetStatus(ctx))
+	})
+}
+
+// statusChanged tells whether the phase or the conditions of the cluster differ.
+func statusChanged(previous, current *v1alpha1.ClusterStatus) bool {
+	if previous.GetPhase() != current.GetPhase() || len(previous.GetConditions()) != len(current.GetConditions()) {
+		return true
+	}
+	for i, condition := range current.GetConditions() {
+		if !proto.Equal(condition, previous.GetConditions()[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// newCluster returns the cluster of the spec and status at the current resource version.
+func (s *LiveStatus) newCluster(ctx context.Context, spec *v1alpha1.ClusterSpec, status *v1alpha1.ClusterStatus) *v1alpha1.Cluster {
+	logger := log.From(ctx).WithName("cluster-store")
+	resourceVersion, err := s.GetResourceVersion(ctx)
+	if err != nil {
+		logger.Error(err, "error occurred while getting the resource version of the cluster")
+	}
+	return &v1alpha1.Cluster{
+		ApiVersion:      "v1alpha1",
+		Kind:            "Cluster",
+		Spec:            spec,
+		Status:          status,
+		ResourceVersion: resourceVersion,
+	}
+}
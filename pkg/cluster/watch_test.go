@@ -0,0 +1,128 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWatchHub(t *testing.T) {
+	previous := clusterWatches
+	clusterWatches = newWatchHub()
+	t.Cleanup(func() { clusterWatches = previous })
+}
+
+// received returns the events of the watch waiting to be received.
+func received(w *ClusterWatch) []*v1alpha1.WatchClusterEvent {
+	var events []*v1alpha1.WatchClusterEvent
+	for {
+		select {
+		case event, ok := <-w.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestLiveStatus_WatchCluster(t *testing.T) {
+	newTestWatchHub(t)
+	ctx := context.Background()
+	s := newTestStatus(t)
+	s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "edge", Version: "v1.29.0"})
+
+	w := s.WatchCluster(ctx, 0)
+	defer w.Stop()
+	events := received(w)
+	require.Len(t, events, 1)
+	require.Equal(t, constants.WatchEventSync, events[0].Type)
+	require.Equal(t, int64(1), events[0].Revision)
+	require.Equal(t, "edge", events[0].Cluster.Spec.ClusterName)
+	require.Equal(t, constants.ClusterPhaseNotInitialised, events[0].Cluster.Status.Phase)
+
+	status := &v1alpha1.ClusterStatus{Phase: constants.ClusterPhaseProvisioning}
+	s.SetStatus(ctx, status)
+	// a status with the same phase and conditions is no change
+	s.SetStatus(ctx, &v1alpha1.ClusterStatus{Phase: constants.ClusterPhaseProvisioning, KubernetesVersion: "v1.29.0"})
+	s.SetStatus(ctx, &v1alpha1.ClusterStatus{Phase: constants.ClusterPhaseProvisioning,
+		Conditions: []*v1alpha1.Condition{{Type: v1alpha1.ConditionType_ControlPlaneReady, Status: "True"}}})
+	s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "edge", Version: "v1.29.0"})
+	s.SetSpec(ctx, &v1alpha1.ClusterSpec{ClusterName: "edge", Version: "v1.30.0"})
+	// the events do not change along with the status they were made of
+	status.Phase = constants.ClusterPhaseFailed
+
+	events = received(w)
+	require.Len(t, events, 3)
+	require.Equal(t, []int64{2, 3, 4}, []int64{events[0].Revision, events[1].Revision, events[2].Revision})
+	require.Equal(t, constants.WatchEventStatusChanged, events[0].Type)
+	require.Equal(t, constants.ClusterPhaseProvisioning, events[0].Cluster.Status.Phase)
+	require.Equal(t, "v1.29.0", events[0].Cluster.Spec.Version)
+	require.Equal(t, constants.WatchEventStatusChanged, events[1].Type)
+	require.Len(t, events[1].Cluster.Status.Conditions, 1)
+	require.Equal(t, constants.WatchEventSpecChanged, events[2].Type)
+	require.Equal(t, "v1.30.0", events[2].Cluster.Spec.Version)
+	require.Len(t, events[2].Cluster.Status.Conditions, 1)
+
+	resumed := s.WatchCluster(ctx, 2)
+	defer resumed.Stop()
+	events = received(resumed)
+	require.Len(t, events, 2)
+	require.Equal(t, int64(3), events[0].Revision)
+	require.Equal(t, int64(4), events[1].Revision)
+
+	// a revision this agent did not reach starts over
+	restarted := s.WatchCluster(ctx, 10)
+	defer restarted.Stop()
+	events = received(restarted)
+	require.Len(t, events, 1)
+	require.Equal(t, constants.WatchEventSync, events[0].Type)
+	require.Equal(t, int64(4), events[0].Revision)
+}
+
+func TestWatchHub(t *testing.T) {
+	h := newWatchHub()
+	current := func() *v1alpha1.Cluster {
+		return &v1alpha1.Cluster{Spec: &v1alpha1.ClusterSpec{}, Status: &v1alpha1.ClusterStatus{}}
+	}
+	for i := 0; i < watchHistorySize+10; i++ {
+		h.publish(constants.WatchEventStatusChanged, current())
+	}
+
+	// the events that are no longer kept are replaced by a sync event
+	w := h.watch(5, current)
+	events := received(w)
+	require.Len(t, events, 1)
+	require.Equal(t, constants.WatchEventSync, events[0].Type)
+	require.Equal(t, int64(watchHistorySize+10), events[0].Revision)
+	w.Stop()
+	w = h.watch(10, current)
+	events = received(w)
+	require.Len(t, events, watchHistorySize)
+	require.Equal(t, int64(11), events[0].Revision)
+	w.Stop()
+
+	w = h.watch(h.revision, current)
+	require.Empty(t, received(w))
+	for i := 0; i <= watchBufferSize; i++ {
+		h.publish(constants.WatchEventStatusChanged, current())
+	}
+	require.True(t, w.Behind())
+	require.Len(t, received(w), watchBufferSize)
+	_, ok := <-w.Events()
+	require.False(t, ok)
+
+	stopped := h.watch(h.revision, current)
+	stopped.Stop()
+	stopped.Stop()
+	h.publish(constants.WatchEventStatusChanged, current())
+	require.False(t, stopped.Behind())
+	require.Empty(t, received(stopped))
+	require.Empty(t, h.watches)
+}
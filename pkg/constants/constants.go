@@ -35,6 +35,12 @@ const (
 	DefaultKubernetesTool = "kubeadm"
 )
 
+// Cluster types
+const (
+	ClusterTypeKubeadm = "kubeadm"
+	ClusterTypeK3s     = "k3s"
+)
+
 // Cluster Phase
 const (
 	ClusterPhaseProvisioning        = "Provisioning"
@@ -47,6 +53,93 @@ const (
 	ClusterPhaseNotInitialised = "NotInitialised"
 	ClusterPhaseFailed         = "Failed"
 	ClusterPhaseDelete         = "Deleted"
+	ClusterPhaseCancelled      = "Cancelled"
+)
+
+// Operation types, as recorded in the audit history and operation checkpoints
+const (
+	OperationInstall    = "Install"
+	OperationUpgrade    = "Upgrade"
+	OperationReset      = "Reset"
+	OperationResetCerts = "Reset Certs"
+	OperationPatch      = "Patch"
+	OperationImport     = "Import"
+)
+
+// Cluster watch event types
+const (
+	WatchEventSync          = "Sync"
+	WatchEventStatusChanged = "StatusChanged"
+	WatchEventSpecChanged   = "SpecChanged"
+)
+
+// Operations
+const (
+	// DefaultMaxParallelTasks bounds the tasks of an operation running at the same time.
+	DefaultMaxParallelTasks = 4
+)
+
+// Audit
+const (
+	// DefaultAuditRetentionCount bounds the entries of the audit history kept in the store.
+	DefaultAuditRetentionCount = 1000
+	// AuditSinkAttempts is the number of times the delivery of an audit entry to a sink is tried
+	// before the sink is retried after AuditSinkRetryInterval.
+	AuditSinkAttempts      = 3
+	AuditSinkBackoff       = time.Second
+	AuditSinkRetryInterval = 30 * time.Second
+	AuditSinkTimeout       = 10 * time.Second
+)
+
+// Idempotency
+const (
+	DefaultIdempotencyKeyTTL = 24 * time.Hour
+)
+
+// Error details
+const (
+	ErrorDomain               = "kubeclusteragent"
+	ReasonOperationInProgress = "OPERATION_IN_PROGRESS"
+	// DefaultRetryDelay is the delay a client waits before retrying a request failed with a retryable error
+	DefaultRetryDelay = 5 * time.Second
+)
+
+// Error reasons
+const (
+	ReasonInvalidSpec               = "INVALID_SPEC"
+	ReasonClusterNotInitialized     = "CLUSTER_NOT_INITIALIZED"
+	ReasonClusterAlreadyInitialized = "CLUSTER_ALREADY_INITIALIZED"
+	ReasonOperationFailed           = "OPERATION_FAILED"
+	ReasonOperationCancelled        = "OPERATION_CANCELLED"
+	ReasonStateStoreUnavailable     = "STATE_STORE_UNAVAILABLE"
+	ReasonAgentStopping             = "AGENT_STOPPING"
+	ReasonWatchBehind               = "WATCH_BEHIND"
+	ReasonResourceVersionConflict   = "RESOURCE_VERSION_CONFLICT"
+	ReasonSpecUnchanged             = "SPEC_UNCHANGED"
+	ReasonNoUpgradePath             = "NO_UPGRADE_PATH"
+	ReasonInvalidRequest            = "INVALID_REQUEST"
+	ReasonOperationNotFound         = "OPERATION_NOT_FOUND"
+	ReasonRevisionNotFound          = "REVISION_NOT_FOUND"
+	ReasonRevisionNotApplicable     = "REVISION_NOT_APPLICABLE"
+	ReasonInvalidArchive            = "INVALID_ARCHIVE"
+	ReasonUnsupportedArchive        = "UNSUPPORTED_ARCHIVE"
+	ReasonStateExportFailed         = "STATE_EXPORT_FAILED"
+	ReasonStateImportFailed         = "STATE_IMPORT_FAILED"
+)
+
+// Operation states
+const (
+	OperationStatePending   = "Pending"
+	OperationStateRunning   = "Running"
+	OperationStateCompleted = "Completed"
+	OperationStateFailed    = "Failed"
+	OperationStateCancelled = "Cancelled"
+)
+
+// Task rollback status
+const (
+	RollbackStatusCompleted = "Completed"
+	RollbackStatusFailed    = "Failed"
 )
 
 // Package Phase
@@ -61,6 +154,18 @@ const (
 const (
 	KubernetesKernelModuleFile = "/etc/modules-load.d/k8s.conf"
 	KubernetesSysctlModuleFile = "/etc/sysctl.d/k8s.conf"
+	FstabFile                  = "/etc/fstab"
+	FstabBackupFile            = "/etc/fstab.bak"
+)
+
+// kubernetes package repository
+const (
+	KubernetesAptSourceFile  = "/etc/apt/sources.list.d/kubernetes.list"
+	KubernetesAptKeyringFile = "/etc/apt/keyrings/kubernetes-apt-keyring.gpg"
+	// KubernetesPackageIndexURL is the package index of the pkgs.k8s.io repository of a minor version like v1.30
+	KubernetesPackageIndexURL = "https://pkgs.k8s.io/core:/stable:/%s/deb/Packages"
+	// KubernetesPackageIndexTimeout bounds the download of a package index
+	KubernetesPackageIndexTimeout = 10 * time.Second
 )
 
 const (
@@ -113,6 +218,20 @@ const (
 const (
 	ResourceDirectory = "/opt/agent/kubeclusteragent/store"
 	CertsDirectory    = "/opt/agent/kubeclusteragent/pki"
+	ScriptDirectory   = "/opt/agent/kubeclusteragent/scripts"
+	// AuditSpoolDirectory holds the audit entries not yet delivered to the audit sinks.
+	AuditSpoolDirectory = "/opt/agent/kubeclusteragent/audit-spool"
+)
+
+// Scripts
+const (
+	// HooksDirectory holds the operation hooks, relative to the script directory.
+	HooksDirectory       = "hooks"
+	DefaultScriptTimeout = 5 * time.Minute
+	MaxScriptTimeout     = 30 * time.Minute
+	HookTimeout          = 30 * time.Minute
+	// MaxScriptOutput is the number of bytes of script output returned by the API.
+	MaxScriptOutput = 64 * 1024
 )
 
 // Server Config
@@ -146,6 +265,14 @@ const (
 	DefaultServiceNetwork = "100.101.0.0/16"
 	DefaultNetworkFolder  = "/etc/systemd/network"
 	SecondaryIPfileName   = "sec_ip.conf"
+
+	// k3s clusters default to the networks of k3s
+	DefaultK3sPodNetwork     = "10.42.0.0/16"
+	DefaultK3sServiceNetwork = "10.43.0.0/16"
+
+	// CNIs installed with a configuration of their own rather than from a manifest
+	CniCalico = "Calico"
+	CniCilium = "Cilium"
 )
 
 // CSI
@@ -188,6 +315,7 @@ const (
 	PodIPv4Reservation           = "100.100.0.0/24"
 	PodIPv6Reservation           = "2001:db8:1::/120"
 	CNIManifestFilePath          = "/opt/cni/calico.yaml"
+	CoreDNSConfigMapFilePath     = "/etc/kubernetes/coredns-configmap.json"
 )
 
 var (
@@ -0,0 +1,112 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/task"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"kubeclusteragent/pkg/util/script"
+	"sync"
+)
+
+// hookNames maps the operations that run hooks to the name used by their hook scripts.
+var hookNames = map[string]string{
+	constants.OperationInstall: "install",
+	constants.OperationUpgrade: "upgrade",
+	constants.OperationReset:   "delete",
+}
+
+var hooks = struct {
+	sync.Mutex
+	dir string
+}{}
+
+// SetHookDirectory enables the operation hooks. An install, upgrade or delete operation runs the
+// pre-<operation> script of the directory before its first task and the post-<operation> script
+// after its last one, an operation without a script for a hook skips it. An empty directory
+// disables the hooks.
+func SetHookDirectory(dir string) {
+	hooks.Lock()
+	defer hooks.Unlock()
+	hooks.dir = dir
+}
+
+func hookDirectory() string {
+	hooks.Lock()
+	defer hooks.Unlock()
+	return hooks.dir
+}
+
+// withHooks adds the hooks of the operation type to its task details. The hooks are regular
+// tasks, a failing hook fails the operation and is checkpointed like any other task. They run in
+// stages of their own, no task runs alongside them.
+func withHooks(operationType, operationID string, taskDetails TaskDetails) TaskDetails {
+	dir := hookDirectory()
+	name, ok := hookNames[operationType]
+	if dir == "" || !ok {
+		return taskDetails
+	}
+	newHook := func(stage string) task.Task {
+		return &hookTask{
+			script:        fmt.Sprintf("%s-%s", stage, name),
+			dir:           dir,
+			operationType: operationType,
+			operationID:   operationID,
+		}
+	}
+	taskDetails.preHooks = []task.Task{newHook("pre")}
+	taskDetails.postHooks = []task.Task{newHook("post")}
+	return taskDetails
+}
+
+// hookTask runs an operator supplied hook script. The script gets the operation and the cluster
+// through its environment, it has nothing to roll back.
+type hookTask struct {
+	script        string
+	dir           string
+	operationType string
+	operationID   string
+}
+
+var _ task.Task = &hookTask{}
+var _ task.WithPolicy = &hookTask{}
+
+func (h *hookTask) Name() string {
+	return h.script + "-hook"
+}
+
+func (h *hookTask) Policy() task.Policy {
+	return task.Policy{Timeout: constants.HookTimeout}
+}
+
+func (h *hookTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	logger := log.From(ctx)
+	env := []string{
+		"CLUSTER_OPERATION=" + h.operationType,
+		"CLUSTER_OPERATION_ID=" + h.operationID,
+		"CLUSTER_NAME=" + clusterSpec.GetClusterName(),
+		"CLUSTER_TYPE=" + clusterSpec.GetClusterType(),
+		"CLUSTER_VERSION=" + clusterSpec.GetVersion(),
+	}
+	result, err := script.NewRunner(h.dir, ou.Exec()).Run(ctx, "", h.script, env, 0)
+	if errors.Is(err, script.ErrNotFound) {
+		logger.Info("No hook script, skipping", "script", h.script)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if result.ExitCode != 0 {
+		return fmt.Errorf("hook %s exited with code %d: %s", h.script, result.ExitCode, string(result.Output))
+	}
+	return nil
+}
+
+func (h *hookTask) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	return nil
+}
@@ -0,0 +1,89 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
+	errorutil "kubeclusteragent/pkg/util/error"
+	"kubeclusteragent/pkg/util/log/log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConflictError is returned when the operation lock is held by another operation.
+type ConflictError struct {
+	OperationID string
+	Operation   string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s operation %s is in progress, retry once it has completed", e.Operation, e.OperationID)
+}
+
+// GRPCStatus returns the Aborted status of the conflict, the request can be retried once the operation
+// in progress has completed.
+func (e *ConflictError) GRPCStatus() *status.Status {
+	return errorutil.New(codes.Aborted, constants.ReasonOperationInProgress, e.Error()).WithOperation(e.OperationID, e.Operation).WithRetry().GRPCStatus()
+}
+
+// Lock acquires the operation lock for the operation, at most one operation mutates the cluster at
+// a time. The lock is acquired atomically in the store, it fails with a *ConflictError naming the
+// operation holding it. An operation must hold the lock before it reads the state it checks.
+func (o *Operation) Lock(ctx context.Context) error {
+	if o.locked {
+		return nil
+	}
+	holder, err := o.clusterStatus.AcquireOperationLock(ctx, &cluster.OperationLock{
+		OperationID: o.id,
+		Operation:   o.operationType,
+		AcquiredAt:  time.Now().UTC(),
+	})
+	if err != nil {
+		return errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "acquire operation lock").WithRetry()
+	}
+	if holder.OperationID != o.id {
+		return &ConflictError{OperationID: holder.OperationID, Operation: holder.Operation}
+	}
+	o.locked = true
+	return nil
+}
+
+// Abandon releases the lock of an operation that is not going to run. Once the operation is
+// registered it does nothing, the lock is released when the operation finishes.
+func (o *Operation) Abandon(ctx context.Context) {
+	if o.registered {
+		return
+	}
+	o.unlock(ctx)
+}
+
+// unlock releases the operation lock, a failure to release is logged.
+func (o *Operation) unlock(ctx context.Context) {
+	if !o.locked {
+		return
+	}
+	logger := log.From(ctx).WithName(o.name)
+	if err := o.clusterStatus.ReleaseOperationLock(ctx, o.id); err != nil {
+		logger.Error(err, "unable to release operation lock", "id", o.id)
+		return
+	}
+	o.locked = false
+}
+
+// ReleaseStaleLock releases the operation lock left behind by an operation that stopped with the
+// agent without a checkpoint to resume it from. It must be called on start-up, before any operation runs.
+func ReleaseStaleLock(ctx context.Context, clusterStatus cluster.Status, checkpoint *cluster.Checkpoint) error {
+	logger := log.From(ctx)
+	lock, err := clusterStatus.GetOperationLock(ctx)
+	if err != nil || lock == nil {
+		return err
+	}
+	if checkpoint != nil && checkpoint.OperationID == lock.OperationID {
+		return nil
+	}
+	logger.Info("Releasing the lock of an operation that is no longer running", "id", lock.OperationID, "operation", lock.Operation)
+	return clusterStatus.ReleaseOperationLock(ctx, lock.OperationID)
+}
@@ -2,71 +2,527 @@ package operations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/task"
+	errorutil "kubeclusteragent/pkg/util/error"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Operation struct {
+	id            string
 	name          string
-	preTasks      []task.Task
-	tasks         []task.Task
-	postTasks     []task.Task
+	operationType string
+	stages        []stage
+	// stagesErr is an invalid task dependency, the operation fails instead of running.
+	stagesErr     error
 	osUtil        linux.OSUtil
+	policies      map[string]task.Policy
 	clusterStatus cluster.Status
 	clusterSpec   *v1alpha1.ClusterSpec
+	// steps are the versions of the steps of an operation made of steps.
+	steps []string
+	// progress guards the state updated by the tasks of a stage running in parallel.
+	progress sync.Mutex
+	// executed holds every task that has been started, in execution order, so that
+	// a failure can unwind them in reverse.
+	executed  []task.Task
+	rollbacks []*v1alpha1.TaskRollback
+	// completed is set for every completed task, indexed across pre-tasks, tasks and post-tasks.
+	completed []bool
+	// running holds the tasks in progress, in the order they were started.
+	running []task.Task
+	// interrupted holds the tasks that may have been running when the agent restarted, they are
+	// rolled back before running again.
+	interrupted map[int]bool
+	resumed     bool
+	// resource is the record of the operation exposed through the API.
+	resource *v1alpha1.Operation
+	// locked is set while the operation holds the operation lock, registered once the operation has
+	// been handed out and is going to run.
+	locked     bool
+	registered bool
+	// mu guards the cancellation of the operation, which is requested from another goroutine.
+	mu              sync.Mutex
+	cancel          context.CancelCauseFunc
+	cancelRequested bool
+}
+
+func NewOperation(name string, operationType string, clusterStatus cluster.Status, clusterSpec *v1alpha1.ClusterSpec, taskDetails TaskDetails) *Operation {
+	return newOperation(uuid.NewString(), name, operationType, clusterStatus, clusterSpec, taskDetails)
 }
 
-func NewOperation(name string, clusterStatus cluster.Status, clusterSpec *v1alpha1.ClusterSpec, taskDetails TaskDetails) *Operation {
+func newOperation(id, name, operationType string, clusterStatus cluster.Status, clusterSpec *v1alpha1.ClusterSpec, taskDetails TaskDetails) *Operation {
+	taskDetails = withHooks(operationType, id, taskDetails)
+	stages, stagesErr := buildStages(taskDetails)
 	o := &Operation{
+		id:            id,
 		name:          name,
+		operationType: operationType,
 		clusterStatus: clusterStatus,
 		clusterSpec:   clusterSpec,
-		preTasks:      taskDetails.PreTasks,
-		tasks:         taskDetails.Tasks,
-		postTasks:     taskDetails.PostTasks,
+		stages:        stages,
+		stagesErr:     stagesErr,
 		osUtil:        taskDetails.OsUtil,
+		policies:      taskDetails.Policies,
+		steps:         taskDetails.Steps,
+	}
+	o.completed = make([]bool, len(o.allTasks()))
+	o.resource = &v1alpha1.Operation{
+		Id:          o.id,
+		Type:        operationType,
+		State:       constants.OperationStatePending,
+		ClusterType: clusterSpec.GetClusterType(),
+		Version:     clusterSpec.GetVersion(),
 	}
 	return o
 }
 
+// ResumeOperation rebuilds an operation interrupted by an agent restart from its checkpoint.
+// The tasks recorded as completed are not run again.
+func ResumeOperation(name string, checkpoint *cluster.Checkpoint, clusterStatus cluster.Status, taskDetails TaskDetails) *Operation {
+	o := newOperation(checkpoint.OperationID, name, checkpoint.Operation, clusterStatus, checkpoint.ClusterSpec, taskDetails)
+	completedTasks := checkpoint.CompletedTasks
+	if completedTasks == nil {
+		for i := 0; i <= checkpoint.LastCompletedTask; i++ {
+			completedTasks = append(completedTasks, i)
+		}
+	}
+	for _, index := range completedTasks {
+		if index >= 0 && index < len(o.completed) {
+			o.completed[index] = true
+		}
+	}
+	o.interrupted = o.interruptedTasks()
+	o.resumed = true
+	return o
+}
+
+// interruptedTasks returns the tasks that were ready to run when the operation was interrupted: the
+// tasks that have not completed while the tasks they depend on have.
+func (o *Operation) interruptedTasks() map[int]bool {
+	interrupted := make(map[int]bool)
+	for _, s := range o.stages {
+		done := make([]bool, len(s.tasks))
+		for i := range s.tasks {
+			done[i] = o.completed[s.offset+i]
+		}
+		stageCompleted := true
+		for i := range s.tasks {
+			if done[i] {
+				continue
+			}
+			stageCompleted = false
+			if completed(s.dependencies[i], done) {
+				interrupted[s.offset+i] = true
+			}
+		}
+		if !stageCompleted {
+			break
+		}
+	}
+	return interrupted
+}
+
+func (o *Operation) ID() string {
+	return o.id
+}
+
+// Register records the operation as pending and returns its resource, so that it can be handed
+// to the client before the operation runs in the background. The operation lock is acquired if the
+// operation does not hold it yet, Register fails with a *ConflictError when another operation does.
+func (o *Operation) Register(ctx context.Context) (*v1alpha1.Operation, error) {
+	if err := o.Lock(ctx); err != nil {
+		return nil, err
+	}
+	o.registered = true
+	track(o)
+	o.saveResource(ctx)
+	return proto.Clone(o.resource).(*v1alpha1.Operation), nil
+}
+
 func (o *Operation) Run(ctx context.Context) error {
 	logger := log.From(ctx).WithName(o.name).WithValues("ClusterType", o.clusterSpec.ClusterType, "version", o.clusterSpec.Version)
-	logger.Info("Starting operation:", "name", o.name)
-	if err := o.runTasks(ctx); err != nil {
+	if o.resumed {
+		logger.Info("Resuming operation:", "name", o.name, "id", o.id, "completedTasks", o.completedCount())
+	} else {
+		logger.Info("Starting operation:", "name", o.name, "id", o.id)
+	}
+	if err := o.Lock(ctx); err != nil {
 		return err
 	}
+	defer o.unlock(ctx)
+	track(o)
+	defer untrack(o)
+	ctx, cancel := o.withCancel(ctx)
+	defer cancel()
+	o.start(ctx)
+	o.saveCheckpoint(ctx)
+	if err := o.runTasks(ctx); err != nil {
+		if errors.Is(context.Cause(ctx), ErrCancelled) && !errors.Is(err, ErrCancelled) {
+			err = fmt.Errorf("%w: %w", ErrCancelled, err)
+		}
+		if errors.Is(err, ErrTaskStillRunning) {
+			// rolling back would change the host next to the task that is still running
+			logger.Error(err, "Operation failed, the executed tasks are not rolled back", "name", o.name)
+		} else {
+			logger.Error(err, "Operation failed, rolling back executed tasks", "name", o.name)
+			// the rollback must run to completion even when the operation was cancelled
+			if rollbackErr := o.rollback(context.WithoutCancel(ctx)); rollbackErr != nil {
+				logger.Error(rollbackErr, "Rollback did not complete cleanly", "name", o.name)
+			}
+		}
+		o.deleteCheckpoint(ctx)
+		o.finish(ctx, err)
+		return o.failure(err)
+	}
+	o.deleteCheckpoint(ctx)
+	o.finish(ctx, nil)
 	logger.Info("Operation completed:", "name", o.name)
 	return nil
 }
 
-func (o *Operation) runTasks(ctx context.Context) error {
-	for _, t := range o.preTasks {
-		if err := o.runTask(ctx, t); err != nil {
-			return fmt.Errorf("failed pre-task (%s): %w", t.Name(), err)
-		}
+// failure returns the error of a failed run, linked to the operation and to the task that failed.
+func (o *Operation) failure(err error) error {
+	failed := errorutil.Wrap(err, codes.Internal, constants.ReasonOperationFailed, "").WithOperation(o.id, o.operationType)
+	var taskErr *TaskError
+	if errors.As(err, &taskErr) {
+		failed.Task = taskErr.Task
+	}
+	if errors.Is(err, ErrCancelled) {
+		failed.Code, failed.Reason = codes.Canceled, constants.ReasonOperationCancelled
 	}
+	return failed
+}
 
-	for _, t := range o.tasks {
-		if err := o.runTask(ctx, t); err != nil {
-			return fmt.Errorf("failed install task (%s): %w", t.Name(), err)
+// Rollback undoes an interrupted operation instead of resuming it. The completed tasks and the
+// interrupted one are rolled back in reverse order, but the ones of the completed steps.
+func (o *Operation) Rollback(ctx context.Context) error {
+	logger := log.From(ctx).WithName(o.name).WithValues("ClusterType", o.clusterSpec.ClusterType, "version", o.clusterSpec.Version)
+	logger.Info("Rolling back interrupted operation:", "name", o.name, "id", o.id)
+	if err := o.Lock(ctx); err != nil {
+		return err
+	}
+	defer o.unlock(ctx)
+	for index, t := range o.allTasks() {
+		if o.completed[index] || o.interrupted[index] {
+			o.executed = append(o.executed, t)
+		}
+		if o.completed[index] && committed(t) {
+			o.executed = nil
 		}
 	}
+	err := o.rollback(ctx)
+	o.deleteCheckpoint(ctx)
+	o.finish(ctx, errors.New("operation was interrupted by an agent restart and rolled back"))
+	return err
+}
 
-	for _, t := range o.postTasks {
-		if err := o.runTask(ctx, t); err != nil {
-			return fmt.Errorf("failed install post-task (%s): %w", t.Name(), err)
+func (o *Operation) runTasks(ctx context.Context) error {
+	if o.stagesErr != nil {
+		return fmt.Errorf("invalid task dependencies: %w", o.stagesErr)
+	}
+	for _, s := range o.stages {
+		if err := o.runStage(ctx, s); err != nil {
+			return err
 		}
 	}
-
 	return nil
 }
 
-func (o *Operation) runTask(ctx context.Context, t task.Task) error {
+// skipTask records a task completed before the operation was interrupted, it is rolled back
+// along with the executed tasks if the operation fails.
+func (o *Operation) skipTask(ctx context.Context, t task.Task) {
+	logger := log.From(ctx).WithName(o.name).WithValues("task", t.Name())
+	logger.Info("Task completed before the operation was interrupted, skipping")
+	o.progress.Lock()
+	defer o.progress.Unlock()
+	o.executed = append(o.executed, t)
+	if committed(t) {
+		o.executed = nil
+	}
+}
+
+// runTask runs the task at the given index across pre-tasks, tasks and post-tasks. It may be
+// called for several tasks of a stage at the same time.
+func (o *Operation) runTask(ctx context.Context, t task.Task, index int) error {
 	logger := log.From(ctx).WithName(o.name).WithValues("task", t.Name())
 	ctx = log.WithExistingLogger(ctx, logger)
-	return t.Run(ctx, o.clusterStatus, o.clusterSpec, o.osUtil)
+	if o.interrupted[index] {
+		// the interrupted task may have partially applied its changes, undo them before running it again
+		logger.Info("Rolling back interrupted task before running it again")
+		if err := t.Rollback(ctx, o.clusterStatus, o.clusterSpec, o.osUtil); err != nil {
+			logger.Error(err, "Unable to rollback interrupted task")
+		}
+	}
+	// a cancelled operation stops between tasks
+	if ctx.Err() != nil {
+		return context.Cause(ctx)
+	}
+	o.taskStarted(ctx, t)
+	err := o.runWithPolicy(ctx, t)
+	o.taskFinished(ctx, t, index, err == nil)
+	return err
+}
+
+// taskStarted records the task before it runs, a failing task may have partially applied its changes.
+func (o *Operation) taskStarted(ctx context.Context, t task.Task) {
+	o.progress.Lock()
+	defer o.progress.Unlock()
+	o.executed = append(o.executed, t)
+	o.running = append(o.running, t)
+	o.resource.CurrentTask = o.runningTasks()
+	o.saveResource(ctx)
+}
+
+// taskFinished records the outcome of the task, a completed task is checkpointed.
+func (o *Operation) taskFinished(ctx context.Context, t task.Task, index int, succeeded bool) {
+	o.progress.Lock()
+	defer o.progress.Unlock()
+	for i, running := range o.running {
+		if running == t {
+			o.running = append(o.running[:i], o.running[i+1:]...)
+			break
+		}
+	}
+	if !succeeded {
+		return
+	}
+	o.completed[index] = true
+	if committed(t) {
+		// the step is kept, a failure of a later step does not roll it back
+		o.executed = nil
+	}
+	o.saveCheckpoint(ctx)
+	if len(o.running) > 0 {
+		o.resource.CurrentTask = o.runningTasks()
+		o.saveResource(ctx)
+	}
+}
+
+// runningTasks returns the names of the tasks in progress.
+func (o *Operation) runningTasks() string {
+	names := make([]string, 0, len(o.running))
+	for _, t := range o.running {
+		names = append(names, t.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func (o *Operation) isCompleted(index int) bool {
+	o.progress.Lock()
+	defer o.progress.Unlock()
+	return o.completed[index]
+}
+
+func (o *Operation) completedCount() int {
+	count := 0
+	for _, done := range o.completed {
+		if done {
+			count++
+		}
+	}
+	return count
+}
+
+func (o *Operation) policy(t task.Task) task.Policy {
+	if policy, ok := o.policies[t.Name()]; ok {
+		return policy
+	}
+	if withPolicy, ok := t.(task.WithPolicy); ok {
+		return withPolicy.Policy()
+	}
+	return task.Policy{}
+}
+
+// runWithPolicy runs the task until it succeeds or its policy is exhausted. Every attempt
+// gets its own deadline, the wait between attempts follows the policy backoff.
+func (o *Operation) runWithPolicy(ctx context.Context, t task.Task) error {
+	logger := log.From(ctx)
+	policy := o.policy(t)
+	attempts := policy.Attempts()
+	backoff := policy.Backoff
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		logger.Info("Running task", "attempt", attempt, "maxAttempts", attempts, "timeout", policy.Timeout.String())
+		err = o.runAttempt(ctx, t, policy.Timeout)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil || attempt == attempts || errors.Is(err, ErrTaskStillRunning) {
+			break
+		}
+		logger.Error(err, "Task attempt failed, retrying", "attempt", attempt, "backoff", backoff.String())
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: %w", ctx.Err(), err)
+		case <-time.After(backoff):
+		}
+		backoff = policy.NextBackoff(backoff)
+	}
+	if attempts > 1 {
+		return fmt.Errorf("giving up after %d attempts: %w", attempts, err)
+	}
+	return err
+}
+
+// taskStopGracePeriod is how long a cancelled attempt waits for its task to return before the task
+// is reported as still running.
+var taskStopGracePeriod = 30 * time.Second
+
+// ErrTaskStillRunning is returned when a task keeps running after its attempt was cancelled. The task
+// may still change the host, so it is neither retried nor rolled back.
+var ErrTaskStillRunning = errors.New("task is still running after it was cancelled")
+
+// runAttempt runs the task once. When the attempt deadline expires the task context is cancelled,
+// which kills the commands it started, and the attempt waits for the task to return so that a retry
+// or a rollback never runs next to it.
+func (o *Operation) runAttempt(ctx context.Context, t task.Task, timeout time.Duration) error {
+	if timeout <= 0 {
+		return t.Run(ctx, o.clusterStatus, o.clusterSpec, o.osUtil)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- t.Run(attemptCtx, o.clusterStatus, o.clusterSpec, o.osUtil)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-attemptCtx.Done():
+	}
+	cancel()
+	err := attemptCtx.Err()
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("task timed out after %s: %w", timeout, err)
+	}
+	select {
+	case <-done:
+		return err
+	case <-time.After(taskStopGracePeriod):
+		return fmt.Errorf("%w, waited %s: %w", ErrTaskStillRunning, taskStopGracePeriod, err)
+	}
+}
+
+func (o *Operation) allTasks() []task.Task {
+	all := make([]task.Task, 0)
+	for _, s := range o.stages {
+		all = append(all, s.tasks...)
+	}
+	return all
+}
+
+// saveCheckpoint records the completed tasks, a failure to save is logged and does not fail the operation.
+func (o *Operation) saveCheckpoint(ctx context.Context) {
+	logger := log.From(ctx).WithName(o.name)
+	lastCompletedTask := -1
+	for lastCompletedTask+1 < len(o.completed) && o.completed[lastCompletedTask+1] {
+		lastCompletedTask++
+	}
+	completedTasks := make([]int, 0)
+	for index, done := range o.completed {
+		if done {
+			completedTasks = append(completedTasks, index)
+		}
+	}
+	err := o.clusterStatus.SetCheckpoint(ctx, &cluster.Checkpoint{
+		OperationID:       o.id,
+		Operation:         o.operationType,
+		ClusterSpec:       o.clusterSpec,
+		LastCompletedTask: lastCompletedTask,
+		CompletedTasks:    completedTasks,
+		Steps:             o.steps,
+		UpdatedAt:         time.Now().UTC(),
+	})
+	if err != nil {
+		logger.Error(err, "unable to save operation checkpoint", "id", o.id)
+	}
+}
+
+// start marks the operation as running. A resumed operation keeps the start time of its first run.
+func (o *Operation) start(ctx context.Context) {
+	o.resource.State = constants.OperationStateRunning
+	o.resource.StartTime = timestamppb.Now()
+	if o.resumed {
+		previous, err := o.clusterStatus.GetOperation(ctx, o.id)
+		if err == nil && previous.GetStartTime() != nil {
+			o.resource.StartTime = previous.GetStartTime()
+		}
+	}
+	o.saveResource(ctx)
+}
+
+// finish moves the operation to its terminal state. A failed operation keeps the task that failed.
+func (o *Operation) finish(ctx context.Context, err error) {
+	o.resource.EndTime = timestamppb.Now()
+	switch {
+	case errors.Is(err, ErrCancelled):
+		o.resource.State = constants.OperationStateCancelled
+		o.resource.Error = err.Error()
+	case err != nil:
+		o.resource.State = constants.OperationStateFailed
+		o.resource.Error = err.Error()
+	default:
+		o.resource.State = constants.OperationStateCompleted
+		o.resource.CurrentTask = ""
+	}
+	o.saveResource(ctx)
+}
+
+// saveResource records the operation, a failure to save is logged and does not fail the operation.
+func (o *Operation) saveResource(ctx context.Context) {
+	logger := log.From(ctx).WithName(o.name)
+	if err := o.clusterStatus.SetOperation(ctx, o.resource); err != nil {
+		logger.Error(err, "unable to save operation", "id", o.id)
+	}
+}
+
+func (o *Operation) deleteCheckpoint(ctx context.Context) {
+	logger := log.From(ctx).WithName(o.name)
+	if err := o.clusterStatus.DeleteCheckpoint(ctx); err != nil {
+		logger.Error(err, "unable to delete operation checkpoint", "id", o.id)
+	}
+}
+
+// rollback undoes every executed task in reverse order. A failing rollback does not stop
+// the remaining ones, all failures are returned together.
+func (o *Operation) rollback(ctx context.Context) error {
+	var err error
+	for i := len(o.executed) - 1; i >= 0; i-- {
+		t := o.executed[i]
+		logger := log.From(ctx).WithName(o.name).WithValues("task", t.Name())
+		logger.Info("Rolling back task")
+		result := &v1alpha1.TaskRollback{
+			Task:   t.Name(),
+			Status: constants.RollbackStatusCompleted,
+		}
+		if rollbackErr := t.Rollback(log.WithExistingLogger(ctx, logger), o.clusterStatus, o.clusterSpec, o.osUtil); rollbackErr != nil {
+			logger.Error(rollbackErr, "Unable to rollback task")
+			result.Status = constants.RollbackStatusFailed
+			result.Reason = rollbackErr.Error()
+			err = multierr.Append(err, fmt.Errorf("rollback task (%s): %w", t.Name(), rollbackErr))
+		}
+		o.rollbacks = append(o.rollbacks, result)
+	}
+	o.executed = nil
+	return err
+}
+
+// RollbackResults returns the outcome of every task rolled back by the last failed Run.
+func (o *Operation) RollbackResults() []*v1alpha1.TaskRollback {
+	return o.rollbacks
 }
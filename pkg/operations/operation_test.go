@@ -0,0 +1,670 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/task"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeTask struct {
+	name        string
+	runErr      error
+	rollbackErr error
+	journal     *[]string
+}
+
+var _ task.Task = &fakeTask{}
+
+func (f *fakeTask) Name() string {
+	return f.name
+}
+
+func (f *fakeTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	*f.journal = append(*f.journal, "run:"+f.name)
+	return f.runErr
+}
+
+func (f *fakeTask) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	*f.journal = append(*f.journal, "rollback:"+f.name)
+	return f.rollbackErr
+}
+
+// flakyTask fails its first failures runs, a blocking task waits for its context instead.
+type flakyTask struct {
+	failures int
+	block    bool
+	policy   task.Policy
+	// runs is updated from the goroutine running the attempt
+	runs atomic.Int32
+}
+
+var _ task.WithPolicy = &flakyTask{}
+
+func (f *flakyTask) Name() string {
+	return "flaky"
+}
+
+func (f *flakyTask) Policy() task.Policy {
+	return f.policy
+}
+
+func (f *flakyTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	runs := f.runs.Add(1)
+	if f.block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if int(runs) <= f.failures {
+		return errors.New("transient failure")
+	}
+	return nil
+}
+
+func (f *flakyTask) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	return nil
+}
+
+// blockingTask signals once it runs and waits for its context to be cancelled.
+type blockingTask struct {
+	fakeTask
+	started chan struct{}
+}
+
+func (b *blockingTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	*b.journal = append(*b.journal, "run:"+b.name)
+	close(b.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// stubbornTask ignores its context and runs for duration, it records how many of its runs overlapped.
+type stubbornTask struct {
+	duration  time.Duration
+	policy    task.Policy
+	runs      atomic.Int32
+	running   atomic.Int32
+	overlaps  atomic.Int32
+	rollbacks atomic.Int32
+}
+
+var _ task.WithPolicy = &stubbornTask{}
+
+func (s *stubbornTask) Name() string {
+	return "stubborn"
+}
+
+func (s *stubbornTask) Policy() task.Policy {
+	return s.policy
+}
+
+func (s *stubbornTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	s.runs.Add(1)
+	if s.running.Add(1) > 1 {
+		s.overlaps.Add(1)
+	}
+	defer s.running.Add(-1)
+	time.Sleep(s.duration)
+	return nil
+}
+
+func (s *stubbornTask) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	if s.running.Load() > 0 {
+		s.overlaps.Add(1)
+	}
+	s.rollbacks.Add(1)
+	return nil
+}
+
+// fakeStatus keeps the operation checkpoint and lock in memory, the remaining cluster.Status methods are not used.
+type fakeStatus struct {
+	cluster.Status
+	checkpoint *cluster.Checkpoint
+	saved      []int
+	operation  *v1alpha1.Operation
+	states     []string
+	lock       *cluster.OperationLock
+}
+
+func (f *fakeStatus) AcquireOperationLock(ctx context.Context, lock *cluster.OperationLock) (*cluster.OperationLock, error) {
+	if f.lock == nil || f.lock.OperationID == lock.OperationID {
+		f.lock = lock
+	}
+	return f.lock, nil
+}
+
+func (f *fakeStatus) ReleaseOperationLock(ctx context.Context, operationID string) error {
+	if f.lock != nil && f.lock.OperationID == operationID {
+		f.lock = nil
+	}
+	return nil
+}
+
+func (f *fakeStatus) GetOperationLock(ctx context.Context) (*cluster.OperationLock, error) {
+	return f.lock, nil
+}
+
+func (f *fakeStatus) SetCheckpoint(ctx context.Context, checkpoint *cluster.Checkpoint) error {
+	f.checkpoint = checkpoint
+	f.saved = append(f.saved, checkpoint.LastCompletedTask)
+	return nil
+}
+
+func (f *fakeStatus) GetCheckpoint(ctx context.Context) (*cluster.Checkpoint, error) {
+	return f.checkpoint, nil
+}
+
+func (f *fakeStatus) DeleteCheckpoint(ctx context.Context) error {
+	f.checkpoint = nil
+	return nil
+}
+
+func (f *fakeStatus) SetOperation(ctx context.Context, operation *v1alpha1.Operation) error {
+	f.operation = proto.Clone(operation).(*v1alpha1.Operation)
+	if len(f.states) == 0 || f.states[len(f.states)-1] != operation.State {
+		f.states = append(f.states, operation.State)
+	}
+	return nil
+}
+
+func (f *fakeStatus) GetOperation(ctx context.Context, id string) (*v1alpha1.Operation, error) {
+	if f.operation == nil || f.operation.Id != id {
+		return nil, nil
+	}
+	return f.operation, nil
+}
+
+func newTaskDetails(journal *[]string, failTask, failRollback string) TaskDetails {
+	newTask := func(name string) task.Task {
+		f := &fakeTask{name: name, journal: journal}
+		if name == failTask {
+			f.runErr = errors.New("run failed")
+		}
+		if name == failRollback {
+			f.rollbackErr = errors.New("rollback failed")
+		}
+		return f
+	}
+	return TaskDetails{
+		PreTasks:  []task.Task{newTask("pre")},
+		Tasks:     []task.Task{newTask("main")},
+		PostTasks: []task.Task{newTask("post")},
+		OsUtil:    linux.NewDryRun(),
+	}
+}
+
+func TestOperation_Run(t *testing.T) {
+	tests := []struct {
+		name          string
+		failTask      string
+		failRollback  string
+		wantErr       bool
+		wantJournal   []string
+		wantRollbacks []*v1alpha1.TaskRollback
+	}{
+		{
+			name:        "all tasks succeed",
+			wantErr:     false,
+			wantJournal: []string{"run:pre", "run:main", "run:post"},
+		},
+		{
+			name:        "pre-task failure rolls back the failed task only",
+			failTask:    "pre",
+			wantErr:     true,
+			wantJournal: []string{"run:pre", "rollback:pre"},
+			wantRollbacks: []*v1alpha1.TaskRollback{
+				{Task: "pre", Status: constants.RollbackStatusCompleted},
+			},
+		},
+		{
+			name:        "post-task failure rolls back in reverse order",
+			failTask:    "post",
+			wantErr:     true,
+			wantJournal: []string{"run:pre", "run:main", "run:post", "rollback:post", "rollback:main", "rollback:pre"},
+			wantRollbacks: []*v1alpha1.TaskRollback{
+				{Task: "post", Status: constants.RollbackStatusCompleted},
+				{Task: "main", Status: constants.RollbackStatusCompleted},
+				{Task: "pre", Status: constants.RollbackStatusCompleted},
+			},
+		},
+		{
+			name:         "failed rollback does not stop remaining rollbacks",
+			failTask:     "post",
+			failRollback: "main",
+			wantErr:      true,
+			wantJournal:  []string{"run:pre", "run:main", "run:post", "rollback:post", "rollback:main", "rollback:pre"},
+			wantRollbacks: []*v1alpha1.TaskRollback{
+				{Task: "post", Status: constants.RollbackStatusCompleted},
+				{Task: "main", Status: constants.RollbackStatusFailed, Reason: "rollback failed"},
+				{Task: "pre", Status: constants.RollbackStatusCompleted},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := make([]string, 0)
+			status := &fakeStatus{}
+			o := NewOperation("test", constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, newTaskDetails(&journal, tt.failTask, tt.failRollback))
+			err := o.Run(context.Background())
+			require.Equal(t, tt.wantErr, err != nil)
+			require.Equal(t, tt.wantJournal, journal)
+			require.Equal(t, tt.wantRollbacks, o.RollbackResults())
+			require.Nil(t, status.checkpoint)
+		})
+	}
+}
+
+func TestOperation_Resource(t *testing.T) {
+	tests := []struct {
+		name            string
+		failTask        string
+		wantState       string
+		wantCurrentTask string
+		wantError       string
+	}{
+		{
+			name:      "completed operation",
+			wantState: constants.OperationStateCompleted,
+		},
+		{
+			name:            "failed operation keeps the failing task",
+			failTask:        "main",
+			wantState:       constants.OperationStateFailed,
+			wantCurrentTask: "main",
+			wantError:       "failed install task (main): run failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := make([]string, 0)
+			status := &fakeStatus{}
+			spec := &v1alpha1.ClusterSpec{ClusterType: "kubeadm", Version: "v1.28.2"}
+			o := NewOperation("test", constants.OperationInstall, status, spec, newTaskDetails(&journal, tt.failTask, ""))
+			registered, err := o.Register(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, o.ID(), registered.Id)
+			require.Equal(t, constants.OperationStatePending, registered.State)
+			require.Equal(t, constants.OperationInstall, registered.Type)
+
+			_ = o.Run(context.Background())
+			require.Equal(t, []string{constants.OperationStatePending, constants.OperationStateRunning, tt.wantState}, status.states)
+			require.Equal(t, tt.wantState, status.operation.State)
+			require.Equal(t, tt.wantCurrentTask, status.operation.CurrentTask)
+			require.Equal(t, tt.wantError, status.operation.Error)
+			require.Equal(t, "kubeadm", status.operation.ClusterType)
+			require.Equal(t, "v1.28.2", status.operation.Version)
+			require.NotNil(t, status.operation.StartTime)
+			require.NotNil(t, status.operation.EndTime)
+			// the registered resource is a snapshot, it is not updated by the running operation
+			require.Equal(t, constants.OperationStatePending, registered.State)
+		})
+	}
+}
+
+func TestOperation_Checkpoints(t *testing.T) {
+	journal := make([]string, 0)
+	status := &fakeStatus{}
+	o := NewOperation("test", constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, newTaskDetails(&journal, "", ""))
+	require.NoError(t, o.Run(context.Background()))
+	require.Equal(t, []int{-1, 0, 1, 2}, status.saved)
+	require.Nil(t, status.checkpoint)
+}
+
+func TestResumeOperation(t *testing.T) {
+	tests := []struct {
+		name              string
+		lastCompletedTask int
+		rollback          bool
+		wantJournal       []string
+	}{
+		{
+			name:              "resume re-runs the interrupted task after undoing it",
+			lastCompletedTask: 0,
+			wantJournal:       []string{"rollback:main", "run:main", "run:post"},
+		},
+		{
+			name:              "resume before any task completed starts from the first task",
+			lastCompletedTask: -1,
+			wantJournal:       []string{"rollback:pre", "run:pre", "run:main", "run:post"},
+		},
+		{
+			name:              "rollback undoes the interrupted and completed tasks in reverse order",
+			lastCompletedTask: 1,
+			rollback:          true,
+			wantJournal:       []string{"rollback:post", "rollback:main", "rollback:pre"},
+		},
+		{
+			name:              "rollback after every task completed undoes all of them",
+			lastCompletedTask: 2,
+			rollback:          true,
+			wantJournal:       []string{"rollback:post", "rollback:main", "rollback:pre"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journal := make([]string, 0)
+			checkpoint := &cluster.Checkpoint{
+				OperationID:       "5d2b3c9e-interrupted",
+				Operation:         constants.OperationInstall,
+				ClusterSpec:       &v1alpha1.ClusterSpec{},
+				LastCompletedTask: tt.lastCompletedTask,
+			}
+			status := &fakeStatus{checkpoint: checkpoint}
+			o := ResumeOperation("test", checkpoint, status, newTaskDetails(&journal, "", ""))
+			require.Equal(t, checkpoint.OperationID, o.ID())
+			if tt.rollback {
+				require.NoError(t, o.Rollback(context.Background()))
+			} else {
+				require.NoError(t, o.Run(context.Background()))
+			}
+			require.Equal(t, tt.wantJournal, journal)
+			require.Nil(t, status.checkpoint)
+		})
+	}
+}
+
+func TestOperation_Policy(t *testing.T) {
+	tests := []struct {
+		name     string
+		task     *flakyTask
+		override *task.Policy
+		wantErr  string
+		wantRuns int32
+	}{
+		{
+			name:     "task without policy runs once",
+			task:     &flakyTask{failures: 1},
+			wantErr:  "transient failure",
+			wantRuns: 1,
+		},
+		{
+			name:     "transient failures are retried",
+			task:     &flakyTask{failures: 2, policy: task.Policy{MaxAttempts: 3, Backoff: time.Millisecond, BackoffFactor: 2}},
+			wantRuns: 3,
+		},
+		{
+			name:     "retries are bounded by max attempts",
+			task:     &flakyTask{failures: 5, policy: task.Policy{MaxAttempts: 2, Backoff: time.Millisecond}},
+			wantErr:  "giving up after 2 attempts: transient failure",
+			wantRuns: 2,
+		},
+		{
+			name:     "attempt is cancelled once the timeout expires",
+			task:     &flakyTask{block: true, policy: task.Policy{Timeout: 10 * time.Millisecond}},
+			wantErr:  "task timed out after 10ms",
+			wantRuns: 1,
+		},
+		{
+			name:     "operation override replaces the task policy",
+			task:     &flakyTask{failures: 1, policy: task.Policy{MaxAttempts: 3, Backoff: time.Millisecond}},
+			override: &task.Policy{MaxAttempts: 1},
+			wantErr:  "transient failure",
+			wantRuns: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskDetails := TaskDetails{Tasks: []task.Task{tt.task}, OsUtil: linux.NewDryRun()}
+			if tt.override != nil {
+				WithTaskPolicy(tt.task.Name(), *tt.override)(&taskDetails)
+			}
+			o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{}, taskDetails)
+			err := o.Run(context.Background())
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+			require.Equal(t, tt.wantRuns, tt.task.runs.Load())
+		})
+	}
+}
+
+func TestOperation_TaskIgnoringContext(t *testing.T) {
+	defer func(grace time.Duration) { taskStopGracePeriod = grace }(taskStopGracePeriod)
+	policy := task.Policy{Timeout: 10 * time.Millisecond, MaxAttempts: 2, Backoff: time.Millisecond}
+
+	t.Run("a timed out task is waited for before it is retried and rolled back", func(t *testing.T) {
+		taskStopGracePeriod = time.Second
+		stubborn := &stubbornTask{duration: 50 * time.Millisecond, policy: policy}
+		o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{},
+			TaskDetails{Tasks: []task.Task{stubborn}, OsUtil: linux.NewDryRun()})
+		err := o.Run(context.Background())
+		require.ErrorContains(t, err, "giving up after 2 attempts: task timed out after 10ms")
+		require.NotErrorIs(t, err, ErrTaskStillRunning)
+		require.Equal(t, int32(2), stubborn.runs.Load())
+		require.Equal(t, int32(1), stubborn.rollbacks.Load())
+		require.Zero(t, stubborn.overlaps.Load())
+	})
+
+	t.Run("a task still running after the grace period fails the operation without retry nor rollback", func(t *testing.T) {
+		taskStopGracePeriod = 10 * time.Millisecond
+		stubborn := &stubbornTask{duration: 200 * time.Millisecond, policy: policy}
+		o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{},
+			TaskDetails{Tasks: []task.Task{stubborn}, OsUtil: linux.NewDryRun()})
+		err := o.Run(context.Background())
+		require.ErrorIs(t, err, ErrTaskStillRunning)
+		require.Equal(t, int32(1), stubborn.runs.Load())
+		require.Zero(t, stubborn.rollbacks.Load())
+	})
+}
+
+func TestPolicy_NextBackoff(t *testing.T) {
+	policy := task.Policy{Backoff: time.Second, BackoffFactor: 2, MaxBackoff: 3 * time.Second}
+	backoff := policy.Backoff
+	got := make([]time.Duration, 0)
+	for i := 0; i < 3; i++ {
+		backoff = policy.NextBackoff(backoff)
+		got = append(got, backoff)
+	}
+	require.Equal(t, []time.Duration{2 * time.Second, 3 * time.Second, 3 * time.Second}, got)
+}
+
+func TestOperation_Cancel(t *testing.T) {
+	journal := make([]string, 0)
+	status := &fakeStatus{}
+	blocking := &blockingTask{fakeTask: fakeTask{name: "main", journal: &journal}, started: make(chan struct{})}
+	taskDetails := newTaskDetails(&journal, "", "")
+	taskDetails.Tasks = []task.Task{blocking}
+	o := NewOperation("test", constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, taskDetails)
+	_, err := o.Register(context.Background())
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- o.Run(context.Background())
+	}()
+	<-blocking.started
+	require.NoError(t, Cancel(o.ID()))
+	err = <-done
+
+	require.ErrorIs(t, err, ErrCancelled)
+	require.Equal(t, []string{"run:pre", "run:main", "rollback:main", "rollback:pre"}, journal)
+	require.Equal(t, constants.OperationStateCancelled, status.operation.State)
+	require.ErrorIs(t, Cancel(o.ID()), ErrNotRunning)
+}
+
+func TestOperation_CancelBeforeRun(t *testing.T) {
+	journal := make([]string, 0)
+	status := &fakeStatus{}
+	o := NewOperation("test", constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, newTaskDetails(&journal, "", ""))
+	_, err := o.Register(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, Cancel(o.ID()))
+
+	err = o.Run(context.Background())
+	require.ErrorIs(t, err, ErrCancelled)
+	require.Empty(t, journal)
+	require.Equal(t, constants.OperationStateCancelled, status.operation.State)
+}
+
+func TestCancel_UnknownOperation(t *testing.T) {
+	require.ErrorIs(t, Cancel("unknown"), ErrNotRunning)
+}
+
+// nodeTask configures the node through the OSUtil it is given.
+type nodeTask struct {
+	name   string
+	path   string
+	runErr error
+}
+
+func (n *nodeTask) Name() string {
+	return n.name
+}
+
+func (n *nodeTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	if err := ou.Filesystem().WriteFile(ctx, n.path, []byte("overlay"), 0644); err != nil {
+		return err
+	}
+	if _, _, err := ou.Exec().Command(ctx, "modprobe", nil, "overlay"); err != nil {
+		return err
+	}
+	if err := ou.Systemd().Restart(ctx, "containerd"); err != nil {
+		return err
+	}
+	return n.runErr
+}
+
+func (n *nodeTask) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	return nil
+}
+
+func TestPlan(t *testing.T) {
+	journal := make([]string, 0)
+	status := &fakeStatus{}
+	path := filepath.Join(t.TempDir(), "containerd.conf")
+	taskDetails := newTaskDetails(&journal, "", "")
+	taskDetails.Tasks = []task.Task{
+		&nodeTask{name: "failing", path: path, runErr: errors.New("plan failed")},
+		&nodeTask{name: "node", path: path},
+	}
+
+	planned := Plan(context.Background(), constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, taskDetails)
+
+	require.Len(t, planned, 4)
+	stages := make([]string, 0, len(planned))
+	names := make([]string, 0, len(planned))
+	for _, p := range planned {
+		stages = append(stages, p.Stage)
+		names = append(names, p.Name)
+	}
+	require.Equal(t, []string{StagePreTask, StageTask, StageTask, StagePostTask}, stages)
+	require.Equal(t, []string{"pre", "failing", "node", "post"}, names)
+	require.Equal(t, []string{"run:pre", "run:post"}, journal)
+
+	require.Equal(t, "plan failed", planned[1].Error)
+	require.Empty(t, planned[2].Error)
+	require.Empty(t, planned[0].Actions)
+	for _, p := range planned[1:3] {
+		require.Len(t, p.Actions, 3)
+		require.Equal(t, linux.ActionWriteFile, p.Actions[0].Kind)
+		require.Contains(t, p.Actions[0].Description, path)
+		require.Equal(t, linux.ActionCommand, p.Actions[1].Kind)
+		require.Equal(t, "modprobe overlay", p.Actions[1].Description)
+		require.Equal(t, linux.ActionSystemd, p.Actions[2].Kind)
+		require.Equal(t, "systemctl restart containerd", p.Actions[2].Description)
+	}
+
+	_, err := os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+	require.Nil(t, status.checkpoint)
+	require.Nil(t, status.operation)
+}
+
+func TestOperation_Hooks(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(t.TempDir(), "hooks.log")
+	hook := "#!/bin/sh\necho \"$0 $CLUSTER_OPERATION $CLUSTER_OPERATION_ID $CLUSTER_VERSION\" >> " + out + "\n"
+	for _, name := range []string{"pre-install", "post-install"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(hook), 0o755))
+	}
+	SetHookDirectory(dir)
+	t.Cleanup(func() { SetHookDirectory("") })
+
+	journal := make([]string, 0)
+	taskDetails := newTaskDetails(&journal, "", "")
+	taskDetails.OsUtil = linux.New()
+	o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{Version: "v1.30.0"}, taskDetails)
+	require.NoError(t, o.Run(context.Background()))
+
+	contents, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "pre-install")+" Install "+o.ID()+" v1.30.0\n"+
+		filepath.Join(dir, "post-install")+" Install "+o.ID()+" v1.30.0\n", string(contents))
+
+	// an operation without hook scripts skips its hooks
+	o = NewOperation("test", constants.OperationReset, &fakeStatus{}, &v1alpha1.ClusterSpec{}, taskDetails)
+	require.NoError(t, o.Run(context.Background()))
+	require.Equal(t, []string{"pre-delete-hook", "pre", "main", "post", "post-delete-hook"}, taskNames(o.allTasks()))
+
+	// a failing hook fails the operation
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pre-upgrade"), []byte("#!/bin/sh\nexit 1\n"), 0o755))
+	journal = journal[:0]
+	o = NewOperation("test", constants.OperationUpgrade, &fakeStatus{}, &v1alpha1.ClusterSpec{}, taskDetails)
+	require.ErrorContains(t, o.Run(context.Background()), "hook pre-upgrade exited with code 1")
+	require.Empty(t, journal)
+}
+
+func taskNames(tasks []task.Task) []string {
+	names := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		names = append(names, t.Name())
+	}
+	return names
+}
+
+func TestOperation_Lock(t *testing.T) {
+	journal := make([]string, 0)
+	status := &fakeStatus{}
+	first := NewOperation("test", constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, newTaskDetails(&journal, "", ""))
+	second := NewOperation("test", constants.OperationPatch, status, &v1alpha1.ClusterSpec{}, newTaskDetails(&journal, "", ""))
+
+	_, err := first.Register(context.Background())
+	require.NoError(t, err)
+	// the lock is not released by abandoning a registered operation
+	first.Abandon(context.Background())
+
+	_, err = second.Register(context.Background())
+	var conflict *ConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, first.ID(), conflict.OperationID)
+	require.Equal(t, constants.OperationInstall, conflict.Operation)
+	require.ErrorAs(t, second.Run(context.Background()), &conflict)
+	require.Empty(t, journal)
+
+	require.NoError(t, first.Run(context.Background()))
+	require.Nil(t, status.lock)
+
+	require.NoError(t, second.Lock(context.Background()))
+	require.NoError(t, second.Lock(context.Background()))
+	second.Abandon(context.Background())
+	require.Nil(t, status.lock)
+}
+
+func TestReleaseStaleLock(t *testing.T) {
+	status := &fakeStatus{lock: &cluster.OperationLock{OperationID: "interrupted", Operation: constants.OperationUpgrade}}
+	require.NoError(t, ReleaseStaleLock(context.Background(), status, &cluster.Checkpoint{OperationID: "interrupted"}))
+	require.NotNil(t, status.lock)
+
+	// the interrupted operation keeps its lock when it is resumed
+	journal := make([]string, 0)
+	resumed := ResumeOperation("test", &cluster.Checkpoint{OperationID: "interrupted", Operation: constants.OperationUpgrade, ClusterSpec: &v1alpha1.ClusterSpec{}, LastCompletedTask: -1}, status, newTaskDetails(&journal, "", ""))
+	require.NoError(t, resumed.Run(context.Background()))
+	require.Nil(t, status.lock)
+
+	status.lock = &cluster.OperationLock{OperationID: "stale", Operation: constants.OperationInstall}
+	require.NoError(t, ReleaseStaleLock(context.Background(), status, nil))
+	require.Nil(t, status.lock)
+}
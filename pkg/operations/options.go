@@ -10,6 +10,21 @@ type TaskDetails struct {
 	Tasks     []task.Task
 	PostTasks []task.Task
 	OsUtil    linux.OSUtil
+	// Policies overrides the execution policy of a task, keyed by task name. Tasks without an
+	// entry use the policy they declare through task.WithPolicy, if any.
+	Policies map[string]task.Policy
+	// Dependencies declares, keyed by task name, the tasks of its stage a task waits for. A task
+	// with an entry starts once those tasks have completed, alongside any other task ready to run,
+	// a task without one waits for the task listed before it. The pre-tasks, tasks and post-tasks
+	// still run one stage after the other.
+	Dependencies map[string][]string
+	// Steps are the Kubernetes versions of the steps of an operation made of steps, they are recorded
+	// in its checkpoint to rebuild it. Set by Steps.
+	Steps []string
+	// preHooks and postHooks are the hooks of the operation, they run before the pre-tasks and
+	// after the post-tasks.
+	preHooks  []task.Task
+	postHooks []task.Task
 }
 
 type Option func(o *TaskDetails)
@@ -19,3 +34,21 @@ func DryRun() Option {
 		o.OsUtil = linux.NewDryRun()
 	}
 }
+
+func WithTaskPolicy(name string, policy task.Policy) Option {
+	return func(o *TaskDetails) {
+		if o.Policies == nil {
+			o.Policies = make(map[string]task.Policy)
+		}
+		o.Policies[name] = policy
+	}
+}
+
+func WithTaskDependencies(name string, dependencies ...string) Option {
+	return func(o *TaskDetails) {
+		if o.Dependencies == nil {
+			o.Dependencies = make(map[string][]string)
+		}
+		o.Dependencies[name] = dependencies
+	}
+}
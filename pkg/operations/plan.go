@@ -0,0 +1,150 @@
+package operations
+
+import (
+	"context"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Stages of the tasks of a planned operation.
+const (
+	StagePreTask  = "pre-task"
+	StageTask     = "task"
+	StagePostTask = "post-task"
+)
+
+// Plan runs the tasks of an operation against a linux.Recorder and returns, in execution order, the
+// changes each task would make to the node. Tasks that would run in parallel are planned one after
+// the other in their listed order. Nothing is changed on the node, and the changes the tasks make
+// to the cluster status are kept in memory. A task that fails to plan reports the failure on its
+// entry and the tasks after it are still planned, task policies are not applied. The hooks of the
+// operation type are planned along with its tasks.
+func Plan(ctx context.Context, operationType string, clusterStatus cluster.Status, clusterSpec *v1alpha1.ClusterSpec, taskDetails TaskDetails) []*v1alpha1.PlannedTask {
+	taskDetails = withHooks(operationType, "", taskDetails)
+	logger := log.From(ctx).WithName("plan "+operationType).WithValues("ClusterType", clusterSpec.GetClusterType(), "version", clusterSpec.GetVersion())
+	recorder := linux.NewRecorder()
+	status := newPlanStatus(clusterStatus, clusterSpec)
+	stages, err := buildStages(taskDetails)
+	if err != nil {
+		logger.Error(err, "Invalid task dependencies, the operation would fail without running any task")
+	}
+	planned := make([]*v1alpha1.PlannedTask, 0)
+	for _, s := range stages {
+		for _, t := range s.tasks {
+			taskLogger := logger.WithValues("task", t.Name())
+			entry := &v1alpha1.PlannedTask{Name: t.Name(), Stage: s.name}
+			if committed(t) {
+				// the Done task of a step records the step, it changes nothing on the node
+				planned = append(planned, entry)
+				continue
+			}
+			if err := t.Run(log.WithExistingLogger(ctx, taskLogger), status, clusterSpec, recorder); err != nil {
+				taskLogger.Error(err, "Unable to plan task")
+				entry.Error = err.Error()
+			}
+			for _, action := range recorder.TakeActions() {
+				entry.Actions = append(entry.Actions, &v1alpha1.PlannedAction{Kind: action.Kind, Description: action.Description})
+			}
+			planned = append(planned, entry)
+		}
+	}
+	return planned
+}
+
+// planStatus keeps the changes made to the cluster status while an operation is planned in memory,
+// everything else is read from the cluster status.
+type planStatus struct {
+	cluster.Status
+	mu         sync.Mutex
+	spec       *v1alpha1.ClusterSpec
+	status     *v1alpha1.ClusterStatus
+	configMaps map[string]*v1.ConfigMap
+}
+
+var _ cluster.Status = &planStatus{}
+
+func newPlanStatus(clusterStatus cluster.Status, clusterSpec *v1alpha1.ClusterSpec) *planStatus {
+	return &planStatus{
+		Status:     clusterStatus,
+		spec:       clusterSpec,
+		configMaps: make(map[string]*v1.ConfigMap),
+	}
+}
+
+func (s *planStatus) ClusterSpec(ctx context.Context) *v1alpha1.ClusterSpec {
+	return s.GetSpec(ctx)
+}
+
+func (s *planStatus) SetSpec(ctx context.Context, spec *v1alpha1.ClusterSpec) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.spec = spec
+}
+
+func (s *planStatus) GetSpec(ctx context.Context) *v1alpha1.ClusterSpec {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.spec
+}
+
+func (s *planStatus) SetStatus(ctx context.Context, status *v1alpha1.ClusterStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = status
+}
+
+func (s *planStatus) GetStatus(ctx context.Context) *v1alpha1.ClusterStatus {
+	s.mu.Lock()
+	status := s.status
+	s.mu.Unlock()
+	if status != nil {
+		return status
+	}
+	return s.Status.GetStatus(ctx)
+}
+
+func (s *planStatus) SetAuditHistory(ctx context.Context, currentOperation *v1alpha1.Operations) error {
+	return nil
+}
+
+func (s *planStatus) PurgeAllClusterData(ctx context.Context) error {
+	return nil
+}
+
+func (s *planStatus) AddSpecRevision(ctx context.Context, revision *v1alpha1.SpecRevision) (*v1alpha1.SpecRevision, error) {
+	return revision, nil
+}
+
+func (s *planStatus) StoreConfigMap(ctx context.Context, configMap *v1.ConfigMap, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.configMaps[name] = configMap
+	return nil
+}
+
+func (s *planStatus) GetConfigMap(ctx context.Context, name string) (*v1.ConfigMap, error) {
+	s.mu.Lock()
+	configMap, ok := s.configMaps[name]
+	s.mu.Unlock()
+	if ok {
+		return configMap, nil
+	}
+	return s.Status.GetConfigMap(ctx, name)
+}
+
+func (s *planStatus) SetCheckpoint(ctx context.Context, checkpoint *cluster.Checkpoint) error {
+	return nil
+}
+
+func (s *planStatus) DeleteCheckpoint(ctx context.Context) error {
+	return nil
+}
+
+func (s *planStatus) SetOperation(ctx context.Context, operation *v1alpha1.Operation) error {
+	return nil
+}
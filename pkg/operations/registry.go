@@ -0,0 +1,85 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	// ErrCancelled is the cause of the context of a cancelled operation. Errors returned by a
+	// cancelled operation wrap it.
+	ErrCancelled = errors.New("operation cancelled")
+	// ErrNotRunning is returned when cancelling an operation that is not in flight.
+	ErrNotRunning = errors.New("operation is not running")
+)
+
+// TaskError is the failure of a task of an operation.
+type TaskError struct {
+	// Stage is the stage of the operation the task ran in
+	Stage string
+	Task  string
+	Err   error
+}
+
+func (e *TaskError) Error() string {
+	return fmt.Sprintf("failed %s (%s): %v", e.Stage, e.Task, e.Err)
+}
+
+func (e *TaskError) Unwrap() error {
+	return e.Err
+}
+
+// inFlight holds the operations that have been registered or started and have not finished yet.
+var inFlight = struct {
+	sync.Mutex
+	operations map[string]*Operation
+}{operations: make(map[string]*Operation)}
+
+func track(o *Operation) {
+	inFlight.Lock()
+	defer inFlight.Unlock()
+	inFlight.operations[o.id] = o
+}
+
+func untrack(o *Operation) {
+	inFlight.Lock()
+	defer inFlight.Unlock()
+	delete(inFlight.operations, o.id)
+}
+
+// Cancel stops the in-flight operation with the given id. The running task is interrupted, the
+// commands it started are killed and the executed tasks are rolled back, Cancel does not wait for it.
+func Cancel(id string) error {
+	inFlight.Lock()
+	o, ok := inFlight.operations[id]
+	inFlight.Unlock()
+	if !ok {
+		return ErrNotRunning
+	}
+	o.requestCancel()
+	return nil
+}
+
+func (o *Operation) requestCancel() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.cancelRequested = true
+	if o.cancel != nil {
+		o.cancel(ErrCancelled)
+	}
+}
+
+// withCancel derives the context the tasks run with. An operation cancelled before it started
+// runs with an already cancelled context.
+func (o *Operation) withCancel(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.cancel = cancel
+	if o.cancelRequested {
+		cancel(ErrCancelled)
+	}
+	return ctx, func() { cancel(nil) }
+}
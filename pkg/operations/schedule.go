@@ -0,0 +1,191 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/task"
+	"sort"
+	"sync"
+
+	"go.uber.org/multierr"
+)
+
+var parallelism = struct {
+	sync.Mutex
+	max int
+}{max: constants.DefaultMaxParallelTasks}
+
+// SetMaxParallelTasks bounds the number of tasks of an operation running at the same time. Values
+// below 1 run a single task at a time.
+func SetMaxParallelTasks(n int) {
+	parallelism.Lock()
+	defer parallelism.Unlock()
+	parallelism.max = n
+}
+
+func maxParallelTasks() int {
+	parallelism.Lock()
+	defer parallelism.Unlock()
+	if parallelism.max < 1 {
+		return 1
+	}
+	return parallelism.max
+}
+
+// stage is a group of tasks of an operation. The stages run one after the other, the tasks of a
+// stage run in the order of their dependencies.
+type stage struct {
+	// name is the stage reported by a plan, label the one reported when a task fails.
+	name  string
+	label string
+	tasks []task.Task
+	// offset is the index of the first task of the stage across the tasks of the operation.
+	offset int
+	// dependencies holds, for every task of the stage, the index of the tasks of the stage it waits for.
+	dependencies [][]int
+}
+
+// buildStages groups the tasks of the operation in stages: the pre-hooks, the pre-tasks, the
+// tasks, the post-tasks and the post-hooks. An invalid dependency is returned along with stages
+// that run the tasks of the stage it belongs to in their listed order, as are dependencies declared
+// for a task the operation does not have.
+func buildStages(taskDetails TaskDetails) ([]stage, error) {
+	groups := []stage{
+		{name: StagePreTask, label: "pre-task", tasks: taskDetails.preHooks},
+		{name: StagePreTask, label: "pre-task", tasks: taskDetails.PreTasks},
+		{name: StageTask, label: "install task", tasks: taskDetails.Tasks},
+		{name: StagePostTask, label: "install post-task", tasks: taskDetails.PostTasks},
+		{name: StagePostTask, label: "install post-task", tasks: taskDetails.postHooks},
+	}
+	stages := make([]stage, 0, len(groups))
+	offset := 0
+	var err error
+	names := make(map[string]bool)
+	for _, s := range groups {
+		if len(s.tasks) == 0 {
+			continue
+		}
+		s.offset = offset
+		offset += len(s.tasks)
+		var dependencyErr error
+		s.dependencies, dependencyErr = resolveDependencies(s.tasks, taskDetails.Dependencies)
+		err = multierr.Append(err, dependencyErr)
+		stages = append(stages, s)
+		for _, t := range s.tasks {
+			names[t.Name()] = true
+		}
+	}
+	declared := make([]string, 0, len(taskDetails.Dependencies))
+	for name := range taskDetails.Dependencies {
+		declared = append(declared, name)
+	}
+	sort.Strings(declared)
+	for _, name := range declared {
+		if !names[name] {
+			err = multierr.Append(err, fmt.Errorf("dependencies are declared for task %s, which is not a task of the operation", name))
+		}
+	}
+	return stages, err
+}
+
+// resolveDependencies returns the tasks each task of a stage waits for. A task without declared
+// dependencies waits for the task listed before it. A dependency must name a task listed before
+// the task in its stage, the closest one when several share the name, so the listed order stays
+// a valid execution order for resumed and rolled back operations.
+func resolveDependencies(tasks []task.Task, declared map[string][]string) ([][]int, error) {
+	dependencies := make([][]int, len(tasks))
+	var err error
+	for i, t := range tasks {
+		names, ok := declared[t.Name()]
+		if !ok {
+			if i > 0 {
+				dependencies[i] = []int{i - 1}
+			}
+			continue
+		}
+		resolved := make([]int, 0, len(names))
+		for _, name := range names {
+			j := lastIndex(tasks[:i], name)
+			if j < 0 {
+				err = multierr.Append(err, fmt.Errorf("task %s depends on %s, which is not listed before it in its stage", t.Name(), name))
+				continue
+			}
+			resolved = append(resolved, j)
+		}
+		if len(resolved) != len(names) && i > 0 {
+			resolved = []int{i - 1}
+		}
+		dependencies[i] = resolved
+	}
+	return dependencies, err
+}
+
+func lastIndex(tasks []task.Task, name string) int {
+	for i := len(tasks) - 1; i >= 0; i-- {
+		if tasks[i].Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// runStage starts every task of the stage once the tasks it depends on have completed, at most
+// maxParallelTasks at a time. The first failure cancels the tasks still running and no other task
+// is started, runStage returns once the running tasks have stopped.
+func (o *Operation) runStage(ctx context.Context, s stage) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	type outcome struct {
+		index int
+		err   error
+	}
+	limit := maxParallelTasks()
+	results := make(chan outcome)
+	started := make([]bool, len(s.tasks))
+	done := make([]bool, len(s.tasks))
+	running := 0
+	var err error
+	for {
+		for i, t := range s.tasks {
+			if err != nil || running >= limit {
+				break
+			}
+			if started[i] || !completed(s.dependencies[i], done) {
+				continue
+			}
+			started[i] = true
+			if o.isCompleted(s.offset + i) {
+				o.skipTask(ctx, t)
+				done[i] = true
+				continue
+			}
+			running++
+			go func(i int) {
+				results <- outcome{index: i, err: o.runTask(ctx, s.tasks[i], s.offset+i)}
+			}(i)
+		}
+		if running == 0 {
+			return err
+		}
+		result := <-results
+		running--
+		if result.err == nil {
+			done[result.index] = true
+			continue
+		}
+		if err == nil {
+			err = &TaskError{Stage: s.label, Task: s.tasks[result.index].Name(), Err: result.err}
+			cancel(err)
+		}
+	}
+}
+
+func completed(dependencies []int, done []bool) bool {
+	for _, dependency := range dependencies {
+		if !done[dependency] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,238 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/task"
+	errorutil "kubeclusteragent/pkg/util/error"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// events is a journal written by tasks running in parallel.
+type events struct {
+	mu      sync.Mutex
+	entries []string
+}
+
+func (e *events) add(entry string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.entries = append(e.entries, entry)
+}
+
+func (e *events) list() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]string{}, e.entries...)
+}
+
+func (e *events) index(entry string) int {
+	for i, got := range e.list() {
+		if got == entry {
+			return i
+		}
+	}
+	return -1
+}
+
+// parallelTask waits for the tasks it meets to be running before it completes, a task that fails
+// waits for them too.
+type parallelTask struct {
+	name    string
+	events  *events
+	started chan struct{}
+	meets   []*parallelTask
+	runErr  error
+}
+
+var _ task.Task = &parallelTask{}
+
+func newParallelTask(name string, e *events) *parallelTask {
+	return &parallelTask{name: name, events: e, started: make(chan struct{})}
+}
+
+func (p *parallelTask) Name() string {
+	return p.name
+}
+
+func (p *parallelTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	p.events.add("start:" + p.name)
+	close(p.started)
+	for _, other := range p.meets {
+		select {
+		case <-other.started:
+		case <-time.After(5 * time.Second):
+			return errors.New(p.name + " did not run alongside " + other.name)
+		}
+	}
+	if p.runErr != nil {
+		return p.runErr
+	}
+	if ctx.Err() != nil {
+		p.events.add("cancelled:" + p.name)
+		return ctx.Err()
+	}
+	p.events.add("end:" + p.name)
+	return nil
+}
+
+func (p *parallelTask) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	p.events.add("rollback:" + p.name)
+	return nil
+}
+
+func TestOperation_Dependencies(t *testing.T) {
+	t.Run("independent tasks run in parallel", func(t *testing.T) {
+		e := &events{}
+		first, second, last := newParallelTask("first", e), newParallelTask("second", e), newParallelTask("last", e)
+		first.meets = []*parallelTask{second}
+		second.meets = []*parallelTask{first}
+		status := &fakeStatus{}
+		o := NewOperation("test", constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, TaskDetails{
+			Tasks:        []task.Task{first, second, last},
+			Dependencies: map[string][]string{"second": {}, "last": {"first", "second"}},
+			OsUtil:       linux.NewDryRun(),
+		})
+		require.NoError(t, o.Run(context.Background()))
+		require.Less(t, e.index("end:first"), e.index("start:last"))
+		require.Less(t, e.index("end:second"), e.index("start:last"))
+		require.Equal(t, 2, status.saved[len(status.saved)-1])
+	})
+
+	t.Run("tasks without dependencies keep their listed order", func(t *testing.T) {
+		e := &events{}
+		first, second := newParallelTask("first", e), newParallelTask("second", e)
+		o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{}, TaskDetails{
+			Tasks:  []task.Task{first, second},
+			OsUtil: linux.NewDryRun(),
+		})
+		require.NoError(t, o.Run(context.Background()))
+		require.Equal(t, []string{"start:first", "end:first", "start:second", "end:second"}, e.list())
+	})
+
+	t.Run("parallelism is bounded", func(t *testing.T) {
+		SetMaxParallelTasks(1)
+		defer SetMaxParallelTasks(constants.DefaultMaxParallelTasks)
+		e := &events{}
+		first, second := newParallelTask("first", e), newParallelTask("second", e)
+		o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{}, TaskDetails{
+			Tasks:        []task.Task{first, second},
+			Dependencies: map[string][]string{"second": {}},
+			OsUtil:       linux.NewDryRun(),
+		})
+		require.NoError(t, o.Run(context.Background()))
+		require.Equal(t, []string{"start:first", "end:first", "start:second", "end:second"}, e.list())
+	})
+
+	t.Run("a failure cancels the tasks running alongside it and rolls back both", func(t *testing.T) {
+		e := &events{}
+		failing, sibling, next := newParallelTask("failing", e), newParallelTask("sibling", e), newParallelTask("next", e)
+		failing.meets = []*parallelTask{sibling}
+		failing.runErr = errors.New("run failed")
+		sibling.meets = []*parallelTask{failing}
+		status := &fakeStatus{}
+		o := NewOperation("test", constants.OperationInstall, status, &v1alpha1.ClusterSpec{}, TaskDetails{
+			Tasks:        []task.Task{failing, sibling, next},
+			Dependencies: map[string][]string{"sibling": {}, "next": {"failing", "sibling"}},
+			OsUtil:       linux.NewDryRun(),
+		})
+		err := o.Run(context.Background())
+		require.ErrorContains(t, err, "failed install task (failing): run failed")
+		var failed *errorutil.Error
+		require.ErrorAs(t, err, &failed)
+		require.Equal(t, codes.Internal, failed.Code)
+		require.Equal(t, constants.ReasonOperationFailed, failed.Reason)
+		require.Equal(t, "failing", failed.Task)
+		require.Equal(t, o.ID(), failed.OperationID)
+		require.Equal(t, -1, e.index("start:next"))
+		require.NotEqual(t, -1, e.index("rollback:failing"))
+		require.NotEqual(t, -1, e.index("rollback:sibling"))
+		require.Equal(t, constants.OperationStateFailed, status.operation.State)
+		require.Nil(t, status.checkpoint)
+	})
+
+	t.Run("a dependency on a task not listed before it fails the operation", func(t *testing.T) {
+		e := &events{}
+		first, second := newParallelTask("first", e), newParallelTask("second", e)
+		o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{}, TaskDetails{
+			Tasks:        []task.Task{first, second},
+			Dependencies: map[string][]string{"first": {"second"}},
+			OsUtil:       linux.NewDryRun(),
+		})
+		err := o.Run(context.Background())
+		require.ErrorContains(t, err, "task first depends on second, which is not listed before it in its stage")
+		require.Empty(t, e.list())
+	})
+
+	t.Run("dependencies of a task the operation does not have fail the operation", func(t *testing.T) {
+		e := &events{}
+		first, second := newParallelTask("first", e), newParallelTask("second", e)
+		o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{}, TaskDetails{
+			Tasks:        []task.Task{first, second},
+			Dependencies: map[string][]string{"secnod": {}},
+			OsUtil:       linux.NewDryRun(),
+		})
+		err := o.Run(context.Background())
+		require.ErrorContains(t, err, "dependencies are declared for task secnod, which is not a task of the operation")
+		require.Empty(t, e.list())
+	})
+
+	t.Run("dependencies may be declared for a task of another stage", func(t *testing.T) {
+		e := &events{}
+		pre, first, second := newParallelTask("pre", e), newParallelTask("first", e), newParallelTask("second", e)
+		o := NewOperation("test", constants.OperationInstall, &fakeStatus{}, &v1alpha1.ClusterSpec{}, TaskDetails{
+			PreTasks:     []task.Task{pre},
+			Tasks:        []task.Task{first, second},
+			Dependencies: map[string][]string{"pre": {}, "second": {"first"}},
+			OsUtil:       linux.NewDryRun(),
+		})
+		require.NoError(t, o.Run(context.Background()))
+	})
+}
+
+func TestResumeOperation_Dependencies(t *testing.T) {
+	newDetails := func(e *events) TaskDetails {
+		return TaskDetails{
+			PreTasks:     []task.Task{newParallelTask("a", e), newParallelTask("b", e), newParallelTask("c", e)},
+			PostTasks:    []task.Task{newParallelTask("post", e)},
+			Dependencies: map[string][]string{"b": {}, "c": {"b"}},
+			OsUtil:       linux.NewDryRun(),
+		}
+	}
+	checkpoint := &cluster.Checkpoint{
+		OperationID:       "5d2b3c9e-interrupted",
+		Operation:         constants.OperationInstall,
+		ClusterSpec:       &v1alpha1.ClusterSpec{},
+		LastCompletedTask: -1,
+		CompletedTasks:    []int{1},
+	}
+
+	t.Run("resume runs the tasks that did not complete", func(t *testing.T) {
+		e := &events{}
+		status := &fakeStatus{checkpoint: checkpoint}
+		o := ResumeOperation("test", checkpoint, status, newDetails(e))
+		require.NoError(t, o.Run(context.Background()))
+		require.Equal(t, -1, e.index("start:b"))
+		// a and c were ready to run when the agent stopped, they are undone before running again
+		require.NotEqual(t, -1, e.index("rollback:a"))
+		require.NotEqual(t, -1, e.index("rollback:c"))
+		require.Equal(t, -1, e.index("rollback:post"))
+		require.Less(t, e.index("end:c"), e.index("start:post"))
+	})
+
+	t.Run("rollback undoes the completed and interrupted tasks", func(t *testing.T) {
+		e := &events{}
+		o := ResumeOperation("test", checkpoint, &fakeStatus{checkpoint: checkpoint}, newDetails(e))
+		require.NoError(t, o.Rollback(context.Background()))
+		require.Equal(t, []string{"rollback:c", "rollback:b", "rollback:a"}, e.list())
+	})
+}
@@ -0,0 +1,83 @@
+package operations
+
+import (
+	"context"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/task"
+	"kubeclusteragent/pkg/util/osutility/linux"
+)
+
+// Step is a step of an operation made of steps, like one minor version of an upgrade through
+// several minor versions.
+type Step struct {
+	// ClusterSpec is the spec the tasks of the step run with
+	ClusterSpec *v1alpha1.ClusterSpec
+	// TaskDetails are the tasks of the step, their dependencies are not kept
+	TaskDetails TaskDetails
+	// Done runs once the tasks of the step completed, it is listed but not run by a plan
+	Done task.Task
+}
+
+// Steps returns the task details of an operation running the steps one after the other. The
+// pre-tasks, tasks and post-tasks of a step run in their listed order with the spec of the step,
+// followed by its Done task. A completed step is kept: when a later step fails only the tasks of the
+// failing step are rolled back, the cluster stays at the last completed step.
+func Steps(steps ...Step) TaskDetails {
+	var details TaskDetails
+	for _, s := range steps {
+		tasks := append(append(append([]task.Task{}, s.TaskDetails.PreTasks...), s.TaskDetails.Tasks...), s.TaskDetails.PostTasks...)
+		for _, t := range tasks {
+			details.Tasks = append(details.Tasks, &stepTask{Task: t, clusterSpec: s.ClusterSpec})
+		}
+		details.Tasks = append(details.Tasks, &stepDone{stepTask{Task: s.Done, clusterSpec: s.ClusterSpec}})
+		details.Steps = append(details.Steps, s.ClusterSpec.GetVersion())
+		if details.OsUtil == nil {
+			details.OsUtil = s.TaskDetails.OsUtil
+		}
+		for name, policy := range s.TaskDetails.Policies {
+			if details.Policies == nil {
+				details.Policies = make(map[string]task.Policy)
+			}
+			details.Policies[name] = policy
+		}
+	}
+	return details
+}
+
+// stepTask is a task of a step, it runs and rolls back with the spec of its step.
+type stepTask struct {
+	task.Task
+	clusterSpec *v1alpha1.ClusterSpec
+}
+
+var _ task.WithPolicy = &stepTask{}
+
+func (t *stepTask) Run(ctx context.Context, status cluster.Status, _ *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	return t.Task.Run(ctx, status, t.clusterSpec, ou)
+}
+
+func (t *stepTask) Rollback(ctx context.Context, status cluster.Status, _ *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	return t.Task.Rollback(ctx, status, t.clusterSpec, ou)
+}
+
+// Policy returns the policy the task declares, the policy overrides of the operation are keyed by
+// task name and apply to the tasks of every step.
+func (t *stepTask) Policy() task.Policy {
+	if withPolicy, ok := t.Task.(task.WithPolicy); ok {
+		return withPolicy.Policy()
+	}
+	return task.Policy{}
+}
+
+// stepDone is the Done task of a step, the tasks executed before it are no longer rolled back once it
+// completed.
+type stepDone struct {
+	stepTask
+}
+
+// committed tells whether the tasks executed before the task are kept when a later task fails.
+func committed(t task.Task) bool {
+	_, ok := t.(*stepDone)
+	return ok
+}
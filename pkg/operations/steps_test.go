@@ -0,0 +1,114 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/task"
+	"kubeclusteragent/pkg/util/osutility/linux"
+
+	"github.com/stretchr/testify/require"
+)
+
+// versionTask journals its runs and rollbacks along with the version of the spec it is given.
+type versionTask struct {
+	fakeTask
+}
+
+func (v *versionTask) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	*v.journal = append(*v.journal, "run:"+v.name+"@"+clusterSpec.GetVersion())
+	return v.runErr
+}
+
+func (v *versionTask) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	*v.journal = append(*v.journal, "rollback:"+v.name+"@"+clusterSpec.GetVersion())
+	return nil
+}
+
+func upgradeSteps(journal *[]string, failVersion string, versions ...string) TaskDetails {
+	steps := make([]Step, 0, len(versions))
+	for _, version := range versions {
+		upgrade := &versionTask{fakeTask{name: "upgrade", journal: journal}}
+		if version == failVersion {
+			upgrade.runErr = errors.New("upgrade failed")
+		}
+		steps = append(steps, Step{
+			ClusterSpec: &v1alpha1.ClusterSpec{ClusterType: "kubeadm", Version: version},
+			TaskDetails: TaskDetails{
+				PreTasks:  []task.Task{&versionTask{fakeTask{name: "cordon", journal: journal}}},
+				Tasks:     []task.Task{upgrade},
+				PostTasks: []task.Task{&versionTask{fakeTask{name: "uncordon", journal: journal}}},
+				OsUtil:    linux.NewDryRun(),
+			},
+			Done: &versionTask{fakeTask{name: "done", journal: journal}},
+		})
+	}
+	return Steps(steps...)
+}
+
+func TestSteps(t *testing.T) {
+	journal := make([]string, 0)
+	status := &fakeStatus{}
+	spec := &v1alpha1.ClusterSpec{ClusterType: "kubeadm", Version: "v1.30.0"}
+	details := upgradeSteps(&journal, "", "v1.28.9", "v1.29.4", "v1.30.0")
+	require.Equal(t, []string{"v1.28.9", "v1.29.4", "v1.30.0"}, details.Steps)
+
+	o := NewOperation("test", constants.OperationUpgrade, status, spec, details)
+	// the steps are checkpointed to rebuild the operation
+	o.saveCheckpoint(context.Background())
+	require.Equal(t, details.Steps, status.checkpoint.Steps)
+	require.NoError(t, o.Run(context.Background()))
+	require.Equal(t, []string{
+		"run:cordon@v1.28.9", "run:upgrade@v1.28.9", "run:uncordon@v1.28.9", "run:done@v1.28.9",
+		"run:cordon@v1.29.4", "run:upgrade@v1.29.4", "run:uncordon@v1.29.4", "run:done@v1.29.4",
+		"run:cordon@v1.30.0", "run:upgrade@v1.30.0", "run:uncordon@v1.30.0", "run:done@v1.30.0",
+	}, journal)
+}
+
+func TestSteps_FailedStep(t *testing.T) {
+	journal := make([]string, 0)
+	status := &fakeStatus{}
+	spec := &v1alpha1.ClusterSpec{ClusterType: "kubeadm", Version: "v1.30.0"}
+	o := NewOperation("test", constants.OperationUpgrade, status, spec, upgradeSteps(&journal, "v1.29.4", "v1.28.9", "v1.29.4", "v1.30.0"))
+	require.Error(t, o.Run(context.Background()))
+	// the completed step is kept, only the tasks of the failing step are rolled back
+	require.Equal(t, []string{
+		"run:cordon@v1.28.9", "run:upgrade@v1.28.9", "run:uncordon@v1.28.9", "run:done@v1.28.9",
+		"run:cordon@v1.29.4", "run:upgrade@v1.29.4",
+		"rollback:upgrade@v1.29.4", "rollback:cordon@v1.29.4",
+	}, journal)
+	require.Equal(t, "upgrade", status.operation.CurrentTask)
+}
+
+func TestSteps_Resume(t *testing.T) {
+	journal := make([]string, 0)
+	checkpoint := &cluster.Checkpoint{
+		OperationID:       "5d2b3c9e-interrupted",
+		Operation:         constants.OperationUpgrade,
+		ClusterSpec:       &v1alpha1.ClusterSpec{ClusterType: "kubeadm", Version: "v1.30.0"},
+		LastCompletedTask: 4,
+		Steps:             []string{"v1.28.9", "v1.29.4"},
+	}
+	status := &fakeStatus{checkpoint: checkpoint}
+	o := ResumeOperation("test", checkpoint, status, upgradeSteps(&journal, "", checkpoint.Steps...))
+	require.NoError(t, o.Rollback(context.Background()))
+	// the rollback of the interrupted operation stops at the completed step
+	require.Equal(t, []string{"rollback:upgrade@v1.29.4", "rollback:cordon@v1.29.4"}, journal)
+}
+
+func TestSteps_Plan(t *testing.T) {
+	journal := make([]string, 0)
+	spec := &v1alpha1.ClusterSpec{ClusterType: "kubeadm", Version: "v1.29.4"}
+	planned := Plan(context.Background(), constants.OperationUpgrade, &fakeStatus{}, spec, upgradeSteps(&journal, "", "v1.28.9", "v1.29.4"))
+	require.Len(t, planned, 8)
+	require.Equal(t, "done", planned[3].Name)
+	// the Done tasks are listed without running
+	require.Equal(t, []string{
+		"run:cordon@v1.28.9", "run:upgrade@v1.28.9", "run:uncordon@v1.28.9",
+		"run:cordon@v1.29.4", "run:upgrade@v1.29.4", "run:uncordon@v1.29.4",
+	}, journal)
+}
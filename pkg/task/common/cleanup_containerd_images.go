@@ -31,6 +31,11 @@ func (t *CleanUpK8sControlPlaneContainerdImages) Run(ctx context.Context,
 	ou linux.OSUtil) error {
 	logger := log.From(ctx).WithName("task").WithName(t.Name())
 	containerdClient, err := cri.NewConnection(constants.ContainerdAddress, constants.ContainerdKubernetesNamespace)
+	if err != nil {
+		logger.Error(err, "error occurred while making containerd connection",
+			"address", constants.ContainerdAddress)
+		return err
+	}
 	defer func(containerdClient cri.Client, ctx context.Context) {
 		err := containerdClient.Close(ctx)
 		if err != nil {
@@ -39,11 +44,6 @@ func (t *CleanUpK8sControlPlaneContainerdImages) Run(ctx context.Context,
 			return
 		}
 	}(containerdClient, ctx)
-	if err != nil {
-		logger.Error(err, "error occurred while making containerd connection",
-			"address", constants.ContainerdAddress)
-		return err
-	}
 	logger.Info("kubernetes version during clean-up",
 		"version", cri.GetImageVersionForCleanup(),
 		"namespace", constants.ContainerdKubernetesNamespace)
@@ -57,7 +57,8 @@ func (t *CleanUpK8sControlPlaneContainerdImages) Run(ctx context.Context,
 		"images", images,
 		"namespace", constants.ContainerdKubernetesNamespace)
 	for _, image := range images {
-		err := containerdClient.DeleteImage(ctx, image)
+		// images are removed through ctr so the removal goes through the OS utility like every other change
+		_, _, err := ou.Exec().Command(ctx, "ctr", nil, "-n="+constants.ContainerdKubernetesNamespace, "images", "rm", image)
 		if err != nil {
 			logger.Error(err, "error occurred while deleting the image",
 				"image", image,
@@ -27,7 +27,7 @@ func (t *CordonNode) Run(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
-	k8sUtility := k8s.K8sUtil{}
+	k8sUtility := k8s.K8sUtil{Kubectl: ou.Kubectl()}
 	logger := log.From(ctx).WithName("task").WithName(t.Name())
 	err := k8sUtility.NodeWorkloadScheduler(ctx, "cordon")
 	if err != nil {
@@ -42,5 +42,13 @@ func (t *CordonNode) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
+	k8sUtility := k8s.K8sUtil{Kubectl: ou.Kubectl()}
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	err := k8sUtility.NodeWorkloadScheduler(ctx, "uncordon")
+	if err != nil {
+		logger.Error(err, "failed to uncordon node")
+		return err
+	}
+	logger.Info("successfully uncordon node")
 	return nil
 }
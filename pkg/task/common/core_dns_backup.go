@@ -4,12 +4,12 @@ import (
 	"context"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/cluster"
-	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/task"
 	"kubeclusteragent/pkg/util/k8s"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
-	"os"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 type CoreDNSBackup struct{}
@@ -30,17 +30,7 @@ func (t *CoreDNSBackup) Run(ctx context.Context,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
 	logger := log.From(ctx).WithName("task").WithName(t.Name())
-	kc, err := os.ReadFile(constants.KubeadmKubeconfigPath)
-	if err != nil {
-		logger.Error(err, "unable to read kubeconfig file from path", "path", constants.KubeadmKubeconfigPath)
-		return err
-	}
-	client, err := k8s.GetKubeClientFromKubeconfig(string(kc))
-	if err != nil {
-		logger.Error(err, "unable to make connection with kubernetes api server")
-		return err
-	}
-	corednsConfigMap, err, isNotFound := k8s.CopyConfigMap(client, ctx, "kube-system", "coredns")
+	corednsConfigMap, isNotFound, err := k8s.CopyConfigMapWithKubectl(ctx, ou.Kubectl(), "kube-system", "coredns")
 	if isNotFound {
 		logger.Info("coredns config-map is not found in the cluster,checking for backup")
 		configMap, err := status.GetConfigMap(ctx, "coredns")
@@ -59,7 +49,7 @@ func (t *CoreDNSBackup) Run(ctx context.Context,
 		logger.Error(err, "unable to store coredns config-map")
 		return err
 	}
-	err = k8s.DeleteConfigMap(client, ctx, "kube-system", "coredns")
+	err = k8s.DeleteConfigMapWithKubectl(ctx, ou.Kubectl(), "kube-system", "coredns")
 	if err != nil {
 		logger.Error(err, "unable remove coredns-config map")
 		return err
@@ -72,5 +62,12 @@ func (t *CoreDNSBackup) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
-	return nil
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	// the backup removes the live config-map, put it back from the stored copy
+	err := NewCoreDNSRestore().Run(ctx, status, clusterSpec, ou)
+	if apierrors.IsAlreadyExists(err) {
+		logger.Info("coredns config-map is still present in the cluster, nothing to restore")
+		return nil
+	}
+	return err
 }
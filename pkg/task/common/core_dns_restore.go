@@ -9,7 +9,6 @@ import (
 	"kubeclusteragent/pkg/util/k8s"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
-	"os"
 )
 
 type CoreDNSRestore struct{}
@@ -30,22 +29,12 @@ func (t *CoreDNSRestore) Run(ctx context.Context,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
 	logger := log.From(ctx).WithName("task").WithName(t.Name())
-	kc, err := os.ReadFile(constants.KubeadmKubeconfigPath)
-	if err != nil {
-		logger.Error(err, "unable to read kubeconfig file from path", "path", constants.KubeadmKubeconfigPath)
-		return err
-	}
-	client, err := k8s.GetKubeClientFromKubeconfig(string(kc))
-	if err != nil {
-		logger.Error(err, "unable to make connection with kubernetes api server")
-		return err
-	}
 	configMap, err := status.GetConfigMap(ctx, "coredns")
 	if err != nil {
 		logger.Error(err, "unable to get core-dns config")
 		return err
 	}
-	err = k8s.CreateConfigMap(client, ctx, "kube-system", configMap)
+	err = k8s.CreateConfigMapWithKubectl(ctx, ou.Kubectl(), ou.Filesystem(), constants.CoreDNSConfigMapFilePath, configMap)
 	if err != nil {
 		logger.Error(err, "unable to create coredns config-map")
 		return err
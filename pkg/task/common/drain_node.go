@@ -47,5 +47,16 @@ func (t *DrainNode) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
+	var hostUitl linux.Host = &linux.LiveHost{}
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	nodeName, err := hostUitl.GetHostname()
+	if err != nil {
+		return fmt.Errorf("kubectl run:  %w", err)
+	}
+	logger.Info("uncordon drained node", "node", nodeName)
+	err = ou.Kubectl().Run(ctx, []string{"uncordon", nodeName}...)
+	if err != nil {
+		return fmt.Errorf("kubectl run  : %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,113 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/k8sversion"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnsupportedPackageManager is returned on a host whose packages are not managed with apt, the
+// Kubernetes packages are only installed from the Debian repositories of pkgs.k8s.io.
+var ErrUnsupportedPackageManager = errors.New("kubernetes packages are only installed on hosts managed with apt")
+
+// checkAptHost fails with ErrUnsupportedPackageManager unless the packages of the host are managed with apt.
+func checkAptHost(ou linux.OSUtil) error {
+	switch ou.PackageManager().(type) {
+	case *linux.LiveAptGetPackageManager, *linux.FakeAptGetPackageManager:
+		return nil
+	}
+	return ErrUnsupportedPackageManager
+}
+
+// AddKubernetesRepository configures the pkgs.k8s.io repository of the minor version of the Kubernetes
+// version, replacing the repository of any other minor version, and updates the package lists.
+func AddKubernetesRepository(ctx context.Context, version string, ou linux.OSUtil) error {
+	logger := log.From(ctx)
+	if err := checkAptHost(ou); err != nil {
+		return err
+	}
+	v, err := k8sversion.Parse(version)
+	if err != nil {
+		return err
+	}
+	minor := v.MinorVersion()
+	kubernetesRepoKey := fmt.Sprintf("https://pkgs.k8s.io/core:/stable:/%s/deb/Release.key", minor)
+	armoredKey := constants.KubernetesAptKeyringFile + ".asc"
+	if _, err := ou.Filesystem().DownloadFileUsingHttp(ctx, kubernetesRepoKey, armoredKey, constants.FilePerm); err != nil {
+		logger.Error(err, "error downloading Kubernetes repository key", "url", kubernetesRepoKey)
+		return err
+	}
+	code, output, err := ou.Exec().Command(ctx, "gpg", nil, "--batch", "--yes", "--dearmor", "-o", constants.KubernetesAptKeyringFile, armoredKey)
+	if err != nil {
+		logger.Error(err, "error importing Kubernetes repository key")
+		return fmt.Errorf("import Kubernetes repository key: %w", err)
+	}
+	if code != 0 {
+		err = fmt.Errorf("import Kubernetes repository key: unexpected exit code %d: %s", code, string(output))
+		logger.Error(err, "error importing Kubernetes repository key")
+		return err
+	}
+	if err := ou.Filesystem().Remove(ctx, armoredKey); err != nil {
+		logger.Error(err, "error removing downloaded Kubernetes repository key", "filename", armoredKey)
+	}
+	repo := fmt.Sprintf("deb [signed-by=%s] https://pkgs.k8s.io/core:/stable:/%s/deb/ /", constants.KubernetesAptKeyringFile, minor)
+	if err := ou.PackageManager().AddRepository(ctx, repo, strings.TrimSuffix(filepath.Base(constants.KubernetesAptSourceFile), ".list")); err != nil {
+		logger.Error(err, "error adding Kubernetes repository")
+		return err
+	}
+	if err := ou.PackageManager().Update(ctx); err != nil {
+		logger.Error(err, "Error updating package list")
+		return err
+	}
+	return nil
+}
+
+// KubernetesPackageVersion returns the newest revision of the package of the Kubernetes version the
+// configured repositories provide, like 1.29.3-1.1 for v1.29.3.
+func KubernetesPackageVersion(ctx context.Context, version string, packageName string, ou linux.OSUtil) (string, error) {
+	v, err := k8sversion.Parse(version)
+	if err != nil {
+		return "", err
+	}
+	code, output, err := ou.Exec().Command(ctx, "apt-cache", nil, "madison", packageName)
+	if err != nil {
+		return "", fmt.Errorf("error querying versions for %s: %v, code:%d", packageName, err, code)
+	}
+	// madison lists the newest revision first
+	for _, packageVersion := range k8sversion.ParseMadison(output, packageName) {
+		if available, err := k8sversion.ParsePackageVersion(packageVersion); err == nil && available.Compare(v) == 0 {
+			return packageVersion, nil
+		}
+	}
+	return "", fmt.Errorf("no matching version found for %s=%s", packageName, version)
+}
+
+// InstallKubernetesPackages installs the packages of the Kubernetes version from the configured
+// repositories and holds them at that version, a newer installed version is downgraded.
+func InstallKubernetesPackages(ctx context.Context, version string, ou linux.OSUtil, packageNames ...string) error {
+	logger := log.From(ctx)
+	if err := checkAptHost(ou); err != nil {
+		return err
+	}
+	packagesWithVersion := make([]string, 0, len(packageNames))
+	for _, pkg := range packageNames {
+		packageVersion, err := KubernetesPackageVersion(ctx, version, pkg, ou)
+		if err != nil {
+			logger.Error(err, "error determining version for package", "pkg", pkg)
+			return err
+		}
+		packagesWithVersion = append(packagesWithVersion, fmt.Sprintf("%s=%s", pkg, packageVersion))
+	}
+	logger.Info("installing packages", "packages", packagesWithVersion)
+	if err := ou.PackageManager().Install(ctx, packagesWithVersion...); err != nil {
+		logger.Error(err, "error installing packages", "packages", packagesWithVersion)
+		return err
+	}
+	return nil
+}
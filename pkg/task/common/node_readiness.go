@@ -6,7 +6,6 @@ import (
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
 	"strings"
-	"time"
 
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/cluster"
@@ -17,6 +16,7 @@ import (
 type NodeReady struct{}
 
 var _ task.Task = &NodeReady{}
+var _ task.WithPolicy = &NodeReady{}
 
 func NewNodeReady() *NodeReady {
 	n := &NodeReady{}
@@ -33,8 +33,6 @@ func (n *NodeReady) Run(ctx context.Context,
 	ou linux.OSUtil) error {
 	logger := log.From(ctx).WithName("task").WithName(n.Name())
 	logger.Info("checking node readiness")
-	var retryCount = 0
-nodeReady:
 	data, err := ou.Kubectl().RunWithResponse(ctx, []string{"get", "nodes"}...)
 	if err != nil {
 		return fmt.Errorf("kubectl run  : %w", err)
@@ -43,20 +41,22 @@ nodeReady:
 		if strings.Contains(data, "NotReady") ||
 			strings.Contains(data, "Unknown") ||
 			strings.Contains(data, "did you specify the right host or port?") {
-			time.Sleep(constants.NodeReadinessRetryInterval)
-			retryCount++
-			if retryCount <= constants.NodeReadinessMaxRetryCount {
-				logger.Info("retrying node readiness")
-				goto nodeReady
-			} else {
-				logger.Error(fmt.Errorf("node is  not ready"), "node is not ready after %vs , failing the cluster installation", constants.NodeReadinessMaxRetryCount*constants.NodeReadinessRetryInterval.Seconds())
-				return fmt.Errorf("node is  not ready")
-			}
+			// the check is retried by the operation according to the task policy
+			return fmt.Errorf("node is  not ready")
 		}
 	}
 	return nil
 }
 
+// Policy polls the node until it reports ready, failing the operation after
+// NodeReadinessMaxRetryCount retries.
+func (n *NodeReady) Policy() task.Policy {
+	return task.Policy{
+		MaxAttempts: constants.NodeReadinessMaxRetryCount + 1,
+		Backoff:     constants.NodeReadinessRetryInterval,
+	}
+}
+
 func (n *NodeReady) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
@@ -26,7 +26,7 @@ func (t *UnCordonNode) Run(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
-	k8sUtility := k8s.K8sUtil{}
+	k8sUtility := k8s.K8sUtil{Kubectl: ou.Kubectl()}
 	return k8sUtility.NodeWorkloadScheduler(ctx, "uncordon")
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
+	"time"
 
 	"kubeclusteragent/pkg/task"
 
@@ -16,6 +17,7 @@ type Reset struct {
 }
 
 var _ task.Task = &Reset{}
+var _ task.WithPolicy = &Reset{}
 
 func NewKubeadmReset() *Reset {
 	t := &Reset{}
@@ -26,6 +28,13 @@ func (r *Reset) Name() string {
 	return "kubeadm-reset"
 }
 
+// Policy bounds kubeadm reset.
+func (r *Reset) Policy() task.Policy {
+	return task.Policy{
+		Timeout: 5 * time.Minute,
+	}
+}
+
 func (r *Reset) Run(
 	ctx context.Context,
 	status cluster.Status,
@@ -11,11 +11,13 @@ import (
 	"kubeclusteragent/pkg/util/osutility/linux"
 	"os"
 	"text/template"
+	"time"
 )
 
 type Cluster struct{}
 
 var _ task.Task = &Cluster{}
+var _ task.WithPolicy = &Cluster{}
 
 func NewInstallCluster() *Cluster {
 	t := &Cluster{}
@@ -27,6 +29,13 @@ func (t *Cluster) Name() string {
 	return "install-k3s-cluster"
 }
 
+// Policy bounds the k3s installation script.
+func (t *Cluster) Policy() task.Policy {
+	return task.Policy{
+		Timeout: 15 * time.Minute,
+	}
+}
+
 func (t *Cluster) Run(
 	ctx context.Context,
 	status cluster.Status,
@@ -56,7 +65,7 @@ func (t *Cluster) Run(
 	if err != nil {
 		return fmt.Errorf("enbaling permission to k3s installer failed: %w", err)
 	}
-	if clusterSpec.Networking.CniManifestURL != "" {
+	if clusterSpec.GetNetworking().GetCniManifestURL() != "" {
 		_, output, err = ou.Exec().Command(ctx, "/bin/sh", append(os.Environ(),
 			"INSTALL_K3S_VERSION="+clusterSpec.Version,
 			"K3S_CONFIG_FILE="+configFilename),
@@ -84,9 +93,29 @@ func (t *Cluster) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithValues(
+		"Cluster Type", clusterSpec.ClusterType,
+		"Version", clusterSpec.Version,
+		"Operation", "Rollback", "Task", t.Name())
+	// the k3s installer drops an uninstall script next to the binary
+	ok, err := ou.Filesystem().Exists(ctx, k3sUninstallScript)
+	if err != nil {
+		return fmt.Errorf("check k3s uninstall script: %w", err)
+	}
+	if !ok {
+		logger.Info("k3s uninstall script not found, nothing to rollback")
+		return nil
+	}
+	_, output, err := ou.Exec().Command(ctx, "/bin/sh", nil, k3sUninstallScript)
+	if err != nil {
+		return fmt.Errorf("k3s uninstall failed: %w", err)
+	}
+	logger.Info("k3s uninstall output ", "output", string(output))
 	return nil
 }
 
+const k3sUninstallScript = "/usr/local/bin/k3s-uninstall.sh"
+
 type k3sTemplateData struct {
 	ClusterCIDR string
 	ServiceCIDR string
@@ -99,9 +128,9 @@ func (t *Cluster) generateTemplate(clusterSpec *v1alpha1.ClusterSpec) ([]byte, e
 		return nil, fmt.Errorf("parse k3s configuration template: %w", err)
 	}
 	data := k3sTemplateData{
-		ClusterCIDR: clusterSpec.Networking.PodSubnet,
-		ServiceCIDR: clusterSpec.Networking.SvcSubnet,
-		CertsSANs:   clusterSpec.ApiServer.CertSANs,
+		ClusterCIDR: clusterSpec.GetNetworking().GetPodSubnet(),
+		ServiceCIDR: clusterSpec.GetNetworking().GetSvcSubnet(),
+		CertsSANs:   clusterSpec.GetApiServer().GetCertSANs(),
 	}
 
 	var buf bytes.Buffer
@@ -35,7 +35,7 @@ func (t *ClusterUpgradeController) Run(
 		"Version", clusterSpec.Version,
 		"Operation", "Install", "Task", t.Name())
 	logger.Info("installing k3s cluster upgrade controller")
-	var kubectlClient linux.Kubectl = &linux.K3sLiveKubectl{}
+	var kubectlClient linux.Kubectl = linux.NewK3sLiveKubectl(ou.Exec())
 	logger.Info("creating system-upgrade namespace for upgrade controller")
 	err := kubectlClient.Run(ctx, []string{"create", "ns", "system-upgrade"}...)
 	if err != nil {
@@ -76,7 +76,7 @@ func (t *Cni) Rollback(ctx context.Context,
 
 func installManifestOrURL(ctx context.Context, manifestData, manifestFilePath, url string, ou linux.OSUtil) error {
 	logger := log.From(ctx)
-	var kubectlClient linux.Kubectl = &linux.K3sLiveKubectl{}
+	var kubectlClient linux.Kubectl = linux.NewK3sLiveKubectl(ou.Exec())
 	manifestToApply := ""
 	if url != "" {
 		manifestToApply = url
@@ -9,7 +9,8 @@ import (
 	"kubeclusteragent/pkg/task"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
-	"os"
+
+	"go.uber.org/multierr"
 )
 
 type ClusterPrerequisites struct{}
@@ -39,13 +40,13 @@ func (c ClusterPrerequisites) Run(ctx context.Context, status cluster.Status, cl
 		return err
 	}
 	// backup /etc/fstab
-	code, _, err = ou.Exec().CommandWithNoLogging(ctx, "cp", nil, []string{"/etc/fstab", "/etc/fstab.bak"}...)
+	code, _, err = ou.Exec().CommandWithNoLogging(ctx, "cp", nil, []string{constants.FstabFile, constants.FstabBackupFile}...)
 	if code != 0 {
 		err = fmt.Errorf("failed to backup /etc/fstab.bak")
 		logger.Error(err, "failed to backup /etc/fstab.bak")
 		return err
 	}
-	code, _, err = ou.Exec().CommandWithNoLogging(ctx, "sed", nil, []string{"-i.bak", "-e", "/\\sswap\\s/s/^/#/", "/etc/fstab"}...)
+	code, _, err = ou.Exec().CommandWithNoLogging(ctx, "sed", nil, []string{"-i.bak", "-e", "/\\sswap\\s/s/^/#/", constants.FstabFile}...)
 	if code != 0 {
 		err = fmt.Errorf("failed to update swappiness in /etc/fstab")
 		logger.Error(err, "failed to switch off swap", "code", code)
@@ -55,31 +56,20 @@ func (c ClusterPrerequisites) Run(ctx context.Context, status cluster.Status, cl
 		logger.Error(err, "failed to update swap/d in /etc/fstab")
 		return err
 	}
-	file, err := ou.Filesystem().Open(ctx, constants.KubernetesKernelModuleFile)
-	if err != nil {
-		logger.Error(err, "unable to read or open", "filename", constants.KubernetesKernelModuleFile)
-		return err
-	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-		}
-	}(file)
 	content := `overlay
 br_netfilter
 `
-	// Write content to the file
-	_, err = file.WriteString(content)
+	err = ou.Filesystem().WriteFile(ctx, constants.KubernetesKernelModuleFile, []byte(content), constants.FilePerm)
 	if err != nil {
 		logger.Error(err, "unable to load kernel content to the file /etc/modules-load.d/k8s.conf")
 		return err
 	}
-	_, _, err = ou.Exec().Command(ctx, "modprobe", []string{"overlay"})
+	_, _, err = ou.Exec().Command(ctx, "modprobe", nil, "overlay")
 	if err != nil {
 		logger.Error(err, "unable to load kernel module overlay")
 		return err
 	}
-	_, _, err = ou.Exec().Command(ctx, "modprobe", []string{"br_netfilter"})
+	_, _, err = ou.Exec().Command(ctx, "modprobe", nil, "br_netfilter")
 	if err != nil {
 		logger.Error(err, "unable to load kernel module br_netfilter")
 		return err
@@ -89,20 +79,7 @@ br_netfilter
 net.bridge.bridge-nf-call-iptables  = 1
 net.ipv4.ip_forward                 = 1
 `
-	file, err = ou.Filesystem().Open(ctx, constants.KubernetesSysctlModuleFile)
-	if err != nil {
-		logger.Error(err, "unable to read or open",
-			"filename", constants.KubernetesSysctlModuleFile)
-		return err
-	}
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-		}
-	}(file)
-
-	// Write content to the file
-	_, err = file.WriteString(content)
+	err = ou.Filesystem().WriteFile(ctx, constants.KubernetesSysctlModuleFile, []byte(content), constants.FilePerm)
 	if err != nil {
 		logger.Error(err, "unable to load kernel content to the file", "filename", constants.KubernetesSysctlModuleFile)
 		return err
@@ -117,5 +94,35 @@ net.ipv4.ip_forward                 = 1
 }
 
 func (c ClusterPrerequisites) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
-	return nil
+	logger := log.From(ctx)
+	logger.Info("rolling back kubernetes prerequisites")
+	var err error
+	ok, existsErr := ou.Filesystem().Exists(ctx, constants.FstabBackupFile)
+	if existsErr != nil {
+		err = multierr.Append(err, existsErr)
+	}
+	if ok {
+		code, _, mvErr := ou.Exec().CommandWithNoLogging(ctx, "mv", nil, []string{constants.FstabBackupFile, constants.FstabFile}...)
+		if mvErr != nil || code != 0 {
+			logger.Error(mvErr, "failed to restore /etc/fstab from backup", "code", code)
+			err = multierr.Append(err, fmt.Errorf("failed to restore %s from %s", constants.FstabFile, constants.FstabBackupFile))
+		} else {
+			code, _, swapErr := ou.Exec().CommandWithNoLogging(ctx, "swapon", nil, []string{"-a"}...)
+			if swapErr != nil || code != 0 {
+				logger.Error(swapErr, "failed to switch on swap", "code", code)
+				err = multierr.Append(err, fmt.Errorf("failed to swapon after restoring %s", constants.FstabFile))
+			}
+		}
+	}
+	for _, file := range []string{constants.KubernetesKernelModuleFile, constants.KubernetesSysctlModuleFile} {
+		if rmErr := ou.Filesystem().RemoveAll(ctx, file); rmErr != nil {
+			logger.Error(rmErr, "unable to remove", "filename", file)
+			err = multierr.Append(err, rmErr)
+		}
+	}
+	if reloadErr := ou.Sysctl().Reload(ctx); reloadErr != nil {
+		logger.Error(reloadErr, "unable to reload sysctl")
+		err = multierr.Append(err, reloadErr)
+	}
+	return err
 }
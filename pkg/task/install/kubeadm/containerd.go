@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
+	"time"
 
 	"kubeclusteragent/pkg/task"
 
@@ -15,6 +16,7 @@ import (
 type ContainerdInstall struct{}
 
 var _ task.Task = &ContainerdInstall{}
+var _ task.WithPolicy = &ContainerdInstall{}
 
 func NewInstallContainerd() *ContainerdInstall {
 	t := &ContainerdInstall{}
@@ -25,6 +27,16 @@ func (t *ContainerdInstall) Name() string {
 	return "containerd-install"
 }
 
+// Policy retries the containerd installation, the package install is safe to repeat.
+func (t *ContainerdInstall) Policy() task.Policy {
+	return task.Policy{
+		Timeout:       10 * time.Minute,
+		MaxAttempts:   3,
+		Backoff:       30 * time.Second,
+		BackoffFactor: 2,
+	}
+}
+
 func (t *ContainerdInstall) Run(
 	ctx context.Context,
 	status cluster.Status,
@@ -60,5 +72,8 @@ func (t *ContainerdInstall) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
+	// containerd is left installed, it may have been present before the agent and be used by other workloads
+	logger := log.From(ctx)
+	logger.Info("containerd is not removed during rollback")
 	return nil
 }
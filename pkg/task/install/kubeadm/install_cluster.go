@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"strings"
 	"text/template"
+	"time"
 
 	"kubeclusteragent/pkg/task"
 
@@ -17,9 +18,14 @@ import (
 	"kubeclusteragent/pkg/cluster"
 )
 
-type Cluster struct{}
+// Cluster initialises the control plane with kubeadm init, unless the node already runs one.
+type Cluster struct {
+	// initialised tells whether Run ran kubeadm init, only then is the control plane reset on rollback
+	initialised bool
+}
 
 var _ task.Task = &Cluster{}
+var _ task.WithPolicy = &Cluster{}
 
 func NewInstallCluster() *Cluster {
 	t := &Cluster{}
@@ -31,6 +37,13 @@ func (t *Cluster) Name() string {
 	return "install-kubeadm-cluster"
 }
 
+// Policy bounds kubeadm init, a failed init leaves state behind and is not retried.
+func (t *Cluster) Policy() task.Policy {
+	return task.Policy{
+		Timeout: 15 * time.Minute,
+	}
+}
+
 var apiServerAddress = ""
 
 func (t *Cluster) Run(
@@ -45,6 +58,7 @@ func (t *Cluster) Run(
 		return fmt.Errorf("file exist error: %w", err)
 	}
 	if ok {
+		logger.Info("Kubernetes control plane already initialised, skipping kubeadm init")
 		return nil
 	}
 	configFilename := "/tmp/kubeadm-config.yaml"
@@ -61,6 +75,8 @@ func (t *Cluster) Run(
 	if runtime.NumCPU() < 2 {
 		logger.Info("this machine has 1 CPU , still we are progressing ")
 	}
+	// a failed kubeadm init leaves a partial control plane behind, it is reset on rollback too
+	t.initialised = true
 	output, err := ou.Kubeadm().Install(ctx, configFilename)
 	if err != nil {
 		return err
@@ -79,6 +95,19 @@ func (t *Cluster) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	if !t.initialised {
+		// the control plane was there before the operation, or was initialised before an agent restart
+		logger.Info("Kubernetes control plane was not initialised by this operation, skipping kubeadm reset")
+		return nil
+	}
+	logger.Info("Resetting partially installed Kubernetes cluster")
+	output, err := ou.Kubeadm().Delete(ctx)
+	if err != nil {
+		logger.Error(err, "kubeadm reset failed")
+		return fmt.Errorf("kubeadm reset: %w", err)
+	}
+	logger.Info("Kubeadm reset output", "KubeadmOutput", output)
 	return nil
 }
 
@@ -114,8 +143,8 @@ func (t *Cluster) generateTemplate(ctx context.Context, clusterSpec *v1alpha1.Cl
 	apiServerAddress := constants.PrivateIPv4Address
 	data := kubeadmTemplateData{
 		KubernetesVersion: clusterSpec.Version,
-		PodSubnet:         clusterSpec.Networking.PodSubnet,
-		ServiceSubnet:     clusterSpec.Networking.SvcSubnet,
+		PodSubnet:         clusterSpec.GetNetworking().GetPodSubnet(),
+		ServiceSubnet:     clusterSpec.GetNetworking().GetSvcSubnet(),
 		ClusterName:       clusterSpec.ClusterName,
 		APIServerAddress:  apiServerAddress,
 		//DNSImageTag:       cpImageTags[constants.CoreDNSImage],
@@ -124,8 +153,8 @@ func (t *Cluster) generateTemplate(ctx context.Context, clusterSpec *v1alpha1.Cl
 		BindPort: constants.DefaultKubernetesBindPort,
 		NodeIP:   fmt.Sprintf("%s,%s", constants.PrivateIPv4Address, constants.PrivateIPv6Address),
 	}
-	if len(clusterSpec.ApiServer.CertSANs) > 0 {
-		data.CertSANs = clusterSpec.ApiServer.CertSANs
+	if len(clusterSpec.GetApiServer().GetCertSANs()) > 0 {
+		data.CertSANs = clusterSpec.GetApiServer().GetCertSANs()
 	}
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, data); err != nil {
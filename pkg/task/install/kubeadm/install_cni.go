@@ -5,15 +5,20 @@ import (
 	"context"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/task"
 	"kubeclusteragent/pkg/util/cni/cilium"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
+	"time"
+
+	"github.com/go-logr/logr"
 )
 
 type Cni struct{}
 
 var _ task.Task = &Cni{}
+var _ task.WithPolicy = &Cni{}
 
 func NewInstallCNI() *Cni {
 	t := &Cni{}
@@ -24,6 +29,16 @@ func (t *Cni) Name() string {
 	return "install-cni"
 }
 
+// Policy retries the manifest apply, which fetches the manifest from a remote URL.
+func (t *Cni) Policy() task.Policy {
+	return task.Policy{
+		Timeout:       5 * time.Minute,
+		MaxAttempts:   3,
+		Backoff:       10 * time.Second,
+		BackoffFactor: 2,
+	}
+}
+
 func (t *Cni) Run(
 	ctx context.Context,
 	status cluster.Status,
@@ -31,25 +46,43 @@ func (t *Cni) Run(
 	ou linux.OSUtil) error {
 	logger := log.From(ctx).WithName("task").WithName(t.Name())
 	logger.Info("installing cni")
-	if clusterSpec.Networking.CniName == "Calico" {
+	return ApplyCNI(ctx, logger, clusterSpec.GetNetworking(), ou)
+}
+
+// ApplyCNI installs the CNI of the networking, applying its manifest or installing cilium.
+func ApplyCNI(ctx context.Context, logger logr.Logger, networking *v1alpha1.ClusterNetworking, ou linux.OSUtil) error {
+	if networking.GetCniName() == constants.CniCalico {
 		// TODO install calico
 		logger.Info("cni is calico")
 	}
-	if clusterSpec.Networking.CniName == "Cilium" {
-		return cilium.Install(ctx, logger, clusterSpec.Networking.Cilium.CliVersion, ou)
+	if networking.GetCniName() == constants.CniCilium {
+		return cilium.Install(ctx, logger, networking.GetCilium().GetCliVersion(), ou)
 	}
-	response, err := ou.Kubectl().RunWithResponse(ctx, "apply", "-f", clusterSpec.Networking.CniManifestURL)
+	response, err := ou.Kubectl().RunWithResponse(ctx, "apply", "-f", networking.GetCniManifestURL())
 	if err != nil {
-		logger.Error(err, "unable to apply CNI present in the give location", "path", clusterSpec.Networking.CniManifestURL)
+		logger.Error(err, "unable to apply CNI present in the give location", "path", networking.GetCniManifestURL())
 		return err
 	}
 	logger.Info("cni installation response", "response", response)
 	return nil
 }
 
+// Rollback removes the CNI of a cluster whose installation failed. The task is only part of the
+// install, a patch applies the CNI with a task of its own which restores the previous one.
 func (t *Cni) Rollback(ctx context.Context,
 	status cluster.Status,
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	// cilium is not installed from a manifest, it is removed along with the cluster by kubeadm reset
+	if clusterSpec.Networking == nil || clusterSpec.Networking.CniName == constants.CniCilium || clusterSpec.Networking.CniManifestURL == "" {
+		return nil
+	}
+	response, err := ou.Kubectl().RunWithResponse(ctx, "delete", "--ignore-not-found", "-f", clusterSpec.Networking.CniManifestURL)
+	if err != nil {
+		logger.Error(err, "unable to delete CNI present in the give location", "path", clusterSpec.Networking.CniManifestURL)
+		return err
+	}
+	logger.Info("cni removal response", "response", response)
 	return nil
 }
@@ -31,10 +31,15 @@ func (t *Csi) Run(
 	clusterSpec *v1alpha1.ClusterSpec,
 	ou linux.OSUtil) error {
 	logger := log.From(ctx).WithName("task").WithName(t.Name())
-	logger.Info("installing csi", "CSI", clusterSpec.Storage.ClusterCsi.Name, "Version", clusterSpec.Storage.ClusterCsi.Version)
-	csiPath := fmt.Sprintf("%s/%s/%s_%s.yaml", constants.RootCSIPath, clusterSpec.Storage.ClusterCsi.Name,
-		clusterSpec.Storage.ClusterCsi.Name,
-		clusterSpec.Storage.ClusterCsi.Version)
+	csi := clusterSpec.GetStorage().GetClusterCsi()
+	if csi == nil {
+		logger.Info("no csi in the cluster spec, nothing to install")
+		return nil
+	}
+	logger.Info("installing csi", "CSI", csi.Name, "Version", csi.Version)
+	csiPath := fmt.Sprintf("%s/%s/%s_%s.yaml", constants.RootCSIPath, csi.Name,
+		csi.Name,
+		csi.Version)
 	exists, err := ou.Filesystem().Exists(ctx, csiPath)
 	if err != nil || !exists {
 		logger.Error(err, "csi doesn't exits in the given path or the path is not correct", "path", csiPath)
@@ -43,11 +48,11 @@ func (t *Csi) Run(
 	response, err := ou.Kubectl().RunWithResponse(ctx, "apply", "-f", csiPath)
 	if err != nil {
 		logger.Error(err, "unable to apply CSI present in the give location", "path", csiPath,
-			"Name", clusterSpec.Storage.ClusterCsi.Name,
-			"Version", clusterSpec.Storage.ClusterCsi.Version)
+			"Name", csi.Name,
+			"Version", csi.Version)
 		return err
 	}
-	logger.Info("csi installation response", "response", response, "CSI", clusterSpec.Storage.ClusterCsi.Name, "Version", clusterSpec.Storage.ClusterCsi.Version)
+	logger.Info("csi installation response", "response", response, "CSI", csi.Name, "Version", csi.Version)
 	return nil
 }
 
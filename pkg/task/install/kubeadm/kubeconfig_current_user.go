@@ -44,5 +44,11 @@ func (t CurrentUserKubeconfig) Run(ctx context.Context, status cluster.Status, c
 }
 
 func (t CurrentUserKubeconfig) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
-	return nil
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	currentUser, err := user.Current()
+	if err != nil {
+		logger.Error(err, "failed to get current user")
+		return err
+	}
+	return ou.Filesystem().RemoveAll(ctx, fmt.Sprintf("%s/.kube/config", currentUser.HomeDir))
 }
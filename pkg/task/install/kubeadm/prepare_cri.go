@@ -22,35 +22,36 @@ var containerdConfigFile = constants.ConfigFileLocation
 func (t *Containerd) Run(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
 	logger := log.From(ctx).WithName("task").WithName(t.Name())
 	logger.Info("preparing containerd for kubernetes installation")
-	config, err := toml.LoadFile(containerdConfigFile)
+	contents, err := ou.Filesystem().ReadFile(ctx, containerdConfigFile)
 	if err != nil {
 		logger.Error(err, "unable to find containerd config file at location,generating the config file",
 			"Location", constants.ConfigFileLocation)
 		dir := filepath.Dir(containerdConfigFile)
-		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		if err := ou.Filesystem().MkdirAll(ctx, dir, os.ModePerm); err != nil {
 			logger.Error(err, "error creating directories for containerd config file")
 			return err
 		}
-		if _, err := os.Stat(containerdConfigFile); os.IsNotExist(err) {
-			// File does not exist, so create it
-			file, err := os.Create(containerdConfigFile)
-			if err != nil {
-				logger.Error(err, "unbale to create containerd config file")
-				return err
-			}
-			defer func(file *os.File) {
-				err := file.Close()
-				if err != nil {
-				}
-			}(file) // Ensure the file is closed after creation
-			logger.Info("containerd config file generated successfully", "location", containerdConfigFile)
-		}
-		_, _, err = ou.Exec().Command(ctx, "containerd", nil, "config", "default", ">", containerdConfigFile)
-		config, err = toml.LoadFile(containerdConfigFile)
+		code, output, err := ou.Exec().Command(ctx, "containerd", nil, "config", "default")
 		if err != nil {
-			logger.Error(err, "failed to generate containerd config", err)
+			logger.Error(err, "failed to generate containerd config")
+			return err
+		}
+		if code != 0 {
+			err = fmt.Errorf("generate containerd config: unexpected exit code %d: %s", code, string(output))
+			logger.Error(err, "failed to generate containerd config")
+			return err
+		}
+		if err := ou.Filesystem().WriteFile(ctx, containerdConfigFile, output, constants.FilePerm); err != nil {
+			logger.Error(err, "unbale to create containerd config file")
 			return err
 		}
+		logger.Info("containerd config file generated successfully", "location", containerdConfigFile)
+		contents = output
+	}
+	config, err := toml.LoadBytes(contents)
+	if err != nil {
+		logger.Error(err, "unable to parse containerd config", "Location", containerdConfigFile)
+		return err
 	}
 	config.SetPath([]string{"plugins", "io.containerd.grpc.v1.cri", "containerd", "runtimes", "runc", "options", "SystemdCgroup"}, true)
 	//cpImageTags, err := criutil.GetK8sControlPlaneImagesFromPropertiesFile()
@@ -99,7 +100,10 @@ func (t *Containerd) Run(ctx context.Context, status cluster.Status, clusterSpec
 		logger.Error(err, "unable to marshall containerd configuration")
 		return err
 	}
-	_, _, err = ou.Exec().Command(ctx, "mv", nil, containerdConfigFile, fmt.Sprintf("%s.original", containerdConfigFile))
+	code, output, err := ou.Exec().Command(ctx, "mv", nil, containerdConfigFile, fmt.Sprintf("%s.original", containerdConfigFile))
+	if err == nil && code != 0 {
+		err = fmt.Errorf("back up containerd config: unexpected exit code %d: %s", code, string(output))
+	}
 	if err != nil {
 		logger.Error(err, "unable to make a copy of containerd configuration file from path", "Location",
 			containerdConfigFile, "BackupLocation", fmt.Sprintf("%s.original", containerdConfigFile))
@@ -110,31 +114,61 @@ func (t *Containerd) Run(ctx context.Context, status cluster.Status, clusterSpec
 		logger.Error(err, "unable to write file to destination", "Location", containerdConfigFile)
 		return err
 	}
-	containerdRetryCount := 0
-restart:
-	err = ou.Systemd().Restart(ctx, "containerd")
-	if err != nil {
-		logger.Error(err, "error occurred while restarting containerd")
-		return err
-	}
-	time.Sleep(10 * time.Second)
-	ok, err := ou.Systemd().IsRunning(ctx, "containerd")
-	if err != nil {
-		logger.Error(err, "error occurred while checking the status of containerd")
-		return err
-	}
-	if !ok {
-		if containerdRetryCount > 3 {
-			logger.Error(fmt.Errorf("unbale to start containerd"), "waited for 30 seconds unable to start containerd")
+	for containerdRetryCount := 0; ; containerdRetryCount++ {
+		err = ou.Systemd().Restart(ctx, "containerd")
+		if err != nil {
+			logger.Error(err, "error occurred while restarting containerd")
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Second):
+		}
+		ok, err := ou.Systemd().IsRunning(ctx, "containerd")
+		if err != nil {
+			logger.Error(err, "error occurred while checking the status of containerd")
+			return err
+		}
+		if ok {
+			break
+		}
+		if containerdRetryCount >= 3 {
+			err = fmt.Errorf("unable to start containerd")
+			logger.Error(err, "waited for 40 seconds unable to start containerd")
+			return err
 		}
-		containerdRetryCount++
-		goto restart
 	}
 	logger.Info("containerd configuration has been updated successfully", "Location", containerdConfigFile)
 	return nil
 }
 
 func (t *Containerd) Rollback(ctx context.Context, status cluster.Status, clusterSpec *v1alpha1.ClusterSpec, ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	backupFile := fmt.Sprintf("%s.original", containerdConfigFile)
+	ok, err := ou.Filesystem().Exists(ctx, backupFile)
+	if err != nil {
+		logger.Error(err, "unable to check containerd configuration backup", "BackupLocation", backupFile)
+		return err
+	}
+	if !ok {
+		logger.Info("containerd configuration backup not found, nothing to restore", "BackupLocation", backupFile)
+		return nil
+	}
+	code, output, err := ou.Exec().Command(ctx, "mv", nil, backupFile, containerdConfigFile)
+	if err == nil && code != 0 {
+		err = fmt.Errorf("restore containerd config: unexpected exit code %d: %s", code, string(output))
+	}
+	if err != nil {
+		logger.Error(err, "unable to restore containerd configuration file", "Location",
+			containerdConfigFile, "BackupLocation", backupFile)
+		return err
+	}
+	if err := ou.Systemd().Restart(ctx, "containerd"); err != nil {
+		logger.Error(err, "error occurred while restarting containerd")
+		return err
+	}
+	logger.Info("containerd configuration has been restored", "Location", containerdConfigFile)
 	return nil
 }
 
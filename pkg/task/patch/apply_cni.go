@@ -0,0 +1,66 @@
+package patch
+
+import (
+	"context"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/task"
+	kubeadmCreate "kubeclusteragent/pkg/task/install/kubeadm"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"time"
+)
+
+// ApplyCNI applies the CNI of a patched cluster spec. Unlike the install, a rollback never removes the
+// CNI of the running cluster, it applies the CNI of the spec the patch replaced again.
+type ApplyCNI struct {
+	previous *v1alpha1.ClusterSpec
+}
+
+var _ task.Task = &ApplyCNI{}
+var _ task.WithPolicy = &ApplyCNI{}
+
+// NewApplyCNI returns the task applying the CNI of the patched spec, previous is the spec the patch
+// replaces, nil when it is not known.
+func NewApplyCNI(previous *v1alpha1.ClusterSpec) *ApplyCNI {
+	t := &ApplyCNI{previous: previous}
+	return t
+}
+
+func (t *ApplyCNI) Name() string {
+	return "apply-cni"
+}
+
+// Policy retries the manifest apply, which fetches the manifest from a remote URL.
+func (t *ApplyCNI) Policy() task.Policy {
+	return task.Policy{
+		Timeout:       5 * time.Minute,
+		MaxAttempts:   3,
+		Backoff:       10 * time.Second,
+		BackoffFactor: 2,
+	}
+}
+
+func (t *ApplyCNI) Run(
+	ctx context.Context,
+	status cluster.Status,
+	clusterSpec *v1alpha1.ClusterSpec,
+	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	logger.Info("applying cni of the patched cluster spec")
+	return kubeadmCreate.ApplyCNI(ctx, logger, clusterSpec.GetNetworking(), ou)
+}
+
+func (t *ApplyCNI) Rollback(
+	ctx context.Context,
+	status cluster.Status,
+	clusterSpec *v1alpha1.ClusterSpec,
+	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(t.Name())
+	if t.previous.GetNetworking() == nil {
+		logger.Info("the cluster spec replaced by the patch is not known, the cni is left as it is")
+		return nil
+	}
+	logger.Info("restoring cni of the cluster spec replaced by the patch")
+	return kubeadmCreate.ApplyCNI(ctx, logger, t.previous.GetNetworking(), ou)
+}
@@ -29,7 +29,7 @@ func (t *UpdateNodeWorkloadSchedule) Run(
 	ou linux.OSUtil) error {
 	// This is only applicable for Patch request , it may happen user wants to disable the workload during cluster creation or upgrade
 	currentClusterSpec := status.GetSpec(ctx)
-	k8sUtility := k8s.K8sUtil{}
+	k8sUtility := k8s.K8sUtil{Kubectl: ou.Kubectl()}
 	if currentClusterSpec.DisableWorkloads != nil {
 		if *currentClusterSpec.DisableWorkloads {
 			return k8sUtility.NodeWorkloadScheduler(ctx, "cordon")
@@ -0,0 +1,42 @@
+package task
+
+import "time"
+
+// Policy bounds the execution of a task. The zero value runs the task once without a timeout.
+type Policy struct {
+	// Timeout bounds a single attempt, zero means no timeout.
+	Timeout time.Duration
+	// MaxAttempts is the number of times the task is run before the operation fails, values below 1 mean a single attempt.
+	MaxAttempts int
+	// Backoff is the wait between the first and the second attempt.
+	Backoff time.Duration
+	// BackoffFactor multiplies the wait after every failed attempt, values below 1 keep it constant.
+	BackoffFactor float64
+	// MaxBackoff caps the wait between attempts, zero means no cap.
+	MaxBackoff time.Duration
+}
+
+// WithPolicy is implemented by tasks that declare their own execution policy.
+type WithPolicy interface {
+	Policy() Policy
+}
+
+// Attempts returns the number of times the task is run before giving up.
+func (p Policy) Attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// NextBackoff returns the wait that follows the given one.
+func (p Policy) NextBackoff(current time.Duration) time.Duration {
+	next := current
+	if p.BackoffFactor > 1 {
+		next = time.Duration(float64(current) * p.BackoffFactor)
+	}
+	if p.MaxBackoff > 0 && next > p.MaxBackoff {
+		next = p.MaxBackoff
+	}
+	return next
+}
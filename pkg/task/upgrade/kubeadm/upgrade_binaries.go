@@ -0,0 +1,134 @@
+package kubeadm
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/k8sversion"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/task"
+	"kubeclusteragent/pkg/task/common"
+)
+
+// binariesPolicy retries the download of the kubernetes packages, the package mirrors are the usual
+// source of transient failures.
+var binariesPolicy = task.Policy{
+	Timeout:       15 * time.Minute,
+	MaxAttempts:   3,
+	Backoff:       30 * time.Second,
+	BackoffFactor: 2,
+}
+
+// Binaries upgrades the kubernetes packages to the version of the spec from the pkgs.k8s.io repository
+// of its minor version and holds them. kubeadm is upgraded before kubeadm upgrade apply, kubelet and
+// kubectl after it, as the kubeadm upgrade documents.
+type Binaries struct {
+	name     string
+	packages []string
+}
+
+var _ task.Task = &Binaries{}
+var _ task.WithPolicy = &Binaries{}
+
+// NewUpgradeKubeadm upgrades the kubeadm package, it runs before kubeadm upgrade apply.
+func NewUpgradeKubeadm() *Binaries {
+	return &Binaries{name: "upgrade-kubeadm", packages: []string{"kubeadm"}}
+}
+
+// NewUpgradeKubelet upgrades the kubelet and kubectl packages, it runs after kubeadm upgrade apply and
+// before kubelet is restarted.
+func NewUpgradeKubelet() *Binaries {
+	return &Binaries{name: "upgrade-kubelet", packages: []string{"kubelet", "kubectl"}}
+}
+
+func (u Binaries) Name() string {
+	return u.name
+}
+
+func (u Binaries) Policy() task.Policy {
+	return binariesPolicy
+}
+
+func (u Binaries) Run(ctx context.Context,
+	status cluster.Status,
+	clusterSpec *v1alpha1.ClusterSpec,
+	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(u.Name())
+	logger.Info("upgrading Kubernetes packages", "packages", u.packages, "version", clusterSpec.Version)
+	if err := common.AddKubernetesRepository(ctx, clusterSpec.Version, ou); err != nil {
+		return err
+	}
+	return common.InstallKubernetesPackages(ctx, clusterSpec.Version, ou, u.packages...)
+}
+
+// Rollback downgrades the packages to the version the cluster ran before the upgrade, along with the
+// repository of its minor version, and holds them there. kubelet is restarted on its previous binary.
+// Once kubeadm upgrade apply upgraded the control plane the packages are kept, older packages would
+// break the version skew supported with the control plane.
+func (u Binaries) Rollback(ctx context.Context,
+	status cluster.Status,
+	clusterSpec *v1alpha1.ClusterSpec,
+	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(u.Name())
+	// the status keeps the version the cluster ran until the upgrade completes
+	previous := status.GetStatus(ctx).GetKubernetesVersion()
+	if previous == "" || previous == clusterSpec.Version {
+		logger.Info("no previous Kubernetes version to restore the packages of")
+		return nil
+	}
+	if upgraded, err := controlPlaneUpgraded(ctx, clusterSpec.Version, ou); err != nil {
+		logger.Error(err, "unable to read the control plane version, restoring the packages")
+	} else if upgraded {
+		logger.Info("control plane already upgraded, keeping the Kubernetes packages", "packages", u.packages, "version", clusterSpec.Version)
+		return nil
+	}
+	logger.Info("restoring Kubernetes packages", "packages", u.packages, "version", previous)
+	if err := common.AddKubernetesRepository(ctx, previous, ou); err != nil {
+		return err
+	}
+	if err := common.InstallKubernetesPackages(ctx, previous, ou, u.packages...); err != nil {
+		return err
+	}
+	for _, pkg := range u.packages {
+		if pkg == "kubelet" {
+			return common.NewKubeletReload().Run(ctx, status, clusterSpec, ou)
+		}
+	}
+	return nil
+}
+
+// controlPlaneUpgraded tells whether the control plane runs the version or a newer one, the version of
+// the image of the kube-apiserver static pod.
+func controlPlaneUpgraded(ctx context.Context, version string, ou linux.OSUtil) (bool, error) {
+	target, err := k8sversion.Parse(version)
+	if err != nil {
+		return false, err
+	}
+	manifest, err := ou.Filesystem().ReadFile(ctx, filepath.Join(constants.StaticPodManifests, "kube-apiserver.yaml"))
+	if err != nil {
+		return false, err
+	}
+	for _, line := range strings.Split(string(manifest), "\n") {
+		image, found := strings.CutPrefix(strings.TrimSpace(line), "image:")
+		if !found {
+			continue
+		}
+		_, tag, found := strings.Cut(strings.Trim(strings.TrimSpace(image), `"'`), "kube-apiserver:")
+		if !found {
+			continue
+		}
+		running, err := k8sversion.Parse(tag)
+		if err != nil {
+			return false, err
+		}
+		return running.Compare(target) >= 0, nil
+	}
+	return false, fmt.Errorf("no kube-apiserver image in %s", constants.StaticPodManifests)
+}
@@ -0,0 +1,172 @@
+package kubeadm
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/task/common"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// packageHost is a dry run OSUtil recording the commands changing the installed packages, apt-cache
+// madison lists one package revision of each of the Kubernetes versions. The kube-apiserver static pod
+// runs the controlPlane version.
+type packageHost struct {
+	*linux.DryRun
+	dnf          bool
+	controlPlane string
+	commands     []string
+}
+
+// manifestFilesystem answers with the kube-apiserver manifest of the control plane version.
+type manifestFilesystem struct {
+	linux.Filesystem
+	controlPlane string
+}
+
+func (f *manifestFilesystem) ReadFile(ctx context.Context, filename string) ([]byte, error) {
+	if filename != "/etc/kubernetes/manifests/kube-apiserver.yaml" {
+		return f.Filesystem.ReadFile(ctx, filename)
+	}
+	return []byte(fmt.Sprintf("spec:\n  containers:\n  - name: kube-apiserver\n    image: registry.k8s.io/kube-apiserver:%s\n", f.controlPlane)), nil
+}
+
+func (h *packageHost) Filesystem() linux.Filesystem {
+	return &manifestFilesystem{Filesystem: h.DryRun.Filesystem(), controlPlane: h.controlPlane}
+}
+
+var _ linux.OSUtil = &packageHost{}
+var _ linux.Exec = &packageHost{}
+
+func (h *packageHost) Exec() linux.Exec {
+	return h
+}
+
+func (h *packageHost) PackageManager() linux.PackageManagerFactory {
+	if h.dnf {
+		return linux.NewDnfLivePackageManager(h, h.Filesystem())
+	}
+	return linux.NewAptGetLivePackageManager(h, h.Filesystem())
+}
+
+func (h *packageHost) Command(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
+	switch {
+	case name == "apt-cache":
+		var output strings.Builder
+		for _, version := range []string{"1.29.3-1.1", "1.28.9-2.1"} {
+			fmt.Fprintf(&output, "%s | %s | https://pkgs.k8s.io/core:/stable:/v1.29/deb  Packages\n", args[1], version)
+		}
+		return 0, []byte(output.String()), nil
+	case name == "apt-mark" || name == "dnf" || (name == "apt-get" && args[0] != "update"):
+		h.commands = append(h.commands, strings.Join(append([]string{name}, args...), " "))
+	}
+	return 0, nil, nil
+}
+
+func (h *packageHost) CommandWithNoLogging(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
+	return h.Command(ctx, name, env, args...)
+}
+
+// versionStatus is a cluster status whose cluster runs version.
+type versionStatus struct {
+	cluster.Status
+	version string
+}
+
+func (s *versionStatus) GetStatus(ctx context.Context) *v1alpha1.ClusterStatus {
+	return &v1alpha1.ClusterStatus{KubernetesVersion: s.version}
+}
+
+func TestBinaries_Commands(t *testing.T) {
+	spec := &v1alpha1.ClusterSpec{ClusterType: "kubeadm", Version: "v1.29.3"}
+	status := &versionStatus{version: "v1.28.9"}
+	tests := []struct {
+		name         string
+		dnf          bool
+		controlPlane string
+		task         *Binaries
+		wantRun      []string
+		wantRollback []string
+	}{
+		{
+			name: "apt upgrades, holds and downgrades kubeadm",
+			task: NewUpgradeKubeadm(),
+			wantRun: []string{
+				"apt-mark unhold kubeadm",
+				"apt-get install -y --allow-downgrades kubeadm=1.29.3-1.1",
+				"apt-mark hold kubeadm",
+			},
+			wantRollback: []string{
+				"apt-mark unhold kubeadm",
+				"apt-get install -y --allow-downgrades kubeadm=1.28.9-2.1",
+				"apt-mark hold kubeadm",
+			},
+		},
+		{
+			name: "apt upgrades, holds and downgrades kubelet and kubectl",
+			task: NewUpgradeKubelet(),
+			wantRun: []string{
+				"apt-mark unhold kubelet kubectl",
+				"apt-get install -y --allow-downgrades kubelet=1.29.3-1.1 kubectl=1.29.3-1.1",
+				"apt-mark hold kubelet kubectl",
+			},
+			wantRollback: []string{
+				"apt-mark unhold kubelet kubectl",
+				"apt-get install -y --allow-downgrades kubelet=1.28.9-2.1 kubectl=1.28.9-2.1",
+				"apt-mark hold kubelet kubectl",
+			},
+		},
+		{
+			name: "dnf hosts are refused",
+			dnf:  true,
+			task: NewUpgradeKubeadm(),
+		},
+		{
+			name:         "kubeadm is kept once the control plane is upgraded",
+			controlPlane: "v1.29.3",
+			task:         NewUpgradeKubeadm(),
+			wantRun: []string{
+				"apt-mark unhold kubeadm",
+				"apt-get install -y --allow-downgrades kubeadm=1.29.3-1.1",
+				"apt-mark hold kubeadm",
+			},
+		},
+		{
+			name:         "kubelet and kubectl are kept once the control plane is upgraded",
+			controlPlane: "v1.29.3",
+			task:         NewUpgradeKubelet(),
+			wantRun: []string{
+				"apt-mark unhold kubelet kubectl",
+				"apt-get install -y --allow-downgrades kubelet=1.29.3-1.1 kubectl=1.29.3-1.1",
+				"apt-mark hold kubelet kubectl",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			controlPlane := tt.controlPlane
+			if controlPlane == "" {
+				controlPlane = "v1.28.9"
+			}
+			host := &packageHost{DryRun: linux.NewDryRun(), dnf: tt.dnf, controlPlane: controlPlane}
+			if tt.dnf {
+				require.ErrorIs(t, tt.task.Run(ctx, status, spec, host), common.ErrUnsupportedPackageManager)
+				require.ErrorIs(t, tt.task.Rollback(ctx, status, spec, host), common.ErrUnsupportedPackageManager)
+				require.Empty(t, host.commands)
+				return
+			}
+			require.NoError(t, tt.task.Run(ctx, status, spec, host))
+			require.Equal(t, tt.wantRun, host.commands)
+
+			host.commands = nil
+			require.NoError(t, tt.task.Rollback(ctx, status, spec, host))
+			require.Equal(t, tt.wantRollback, host.commands)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/osutility/linux"
 	"strings"
+	"time"
 
 	"kubeclusteragent/pkg/constants"
 
@@ -20,7 +21,15 @@ func (u Cluster) Name() string {
 	return "upgrade-cluster"
 }
 
+// Policy bounds kubeadm upgrade apply, which waits for every control plane component to restart.
+func (u Cluster) Policy() task.Policy {
+	return task.Policy{
+		Timeout: 30 * time.Minute,
+	}
+}
+
 var _ task.Task = &Cluster{}
+var _ task.WithPolicy = &Cluster{}
 
 func NewUpgradeCluster() *Cluster {
 	t := &Cluster{}
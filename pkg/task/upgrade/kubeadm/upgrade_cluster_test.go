@@ -47,12 +47,10 @@ func TestCluster_Run(t *testing.T) {
 			ClusterType: "kubeadm",
 			ClusterName: "testutil-cluster",
 			Networking: &v1alpha1.ClusterNetworking{
-				PodSubnet: "100.100.0.0/16",
-				SvcSubnet: "100.101.0.0/16",
-				ClusterCni: &v1alpha1.ContainerNetworkInterface{
-					Name:    "calico",
-					Version: "v3.25.1",
-				},
+				PodSubnet:  "100.100.0.0/16",
+				SvcSubnet:  "100.101.0.0/16",
+				CniName:    "calico",
+				CniVersion: "v3.25.1",
 			},
 			Storage: &v1alpha1.ClusterStorage{
 				ClusterCsi: &v1alpha1.ContainerStorageInterface{
@@ -0,0 +1,52 @@
+package kubeadm
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/osutility/linux"
+
+	"kubeclusteragent/pkg/constants"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/task"
+)
+
+// Step records a completed step of an upgrade through several minor versions, the cluster runs the
+// version of the step from then on.
+type Step struct {
+	step  int
+	steps int
+}
+
+var _ task.Task = &Step{}
+
+func NewUpgradeStep(step, steps int) *Step {
+	return &Step{step: step, steps: steps}
+}
+
+func (u Step) Name() string {
+	return fmt.Sprintf("upgrade-step-%d", u.step)
+}
+
+func (u Step) Run(ctx context.Context,
+	status cluster.Status,
+	clusterSpec *v1alpha1.ClusterSpec,
+	ou linux.OSUtil) error {
+	logger := log.From(ctx).WithName("task").WithName(u.Name())
+	logger.Info("upgrade step completed", "step", u.step, "steps", u.steps, "version", clusterSpec.Version)
+	clusterStatus := status.GetStatus(ctx)
+	clusterStatus.KubernetesVersion = clusterSpec.Version
+	status.SetStatus(ctx, clusterStatus)
+	cluster.SetAuditLog(ctx, constants.OperationUpgrade, clusterSpec.ClusterType, clusterSpec.Version, clusterStatus.GetPhase(),
+		fmt.Sprintf("Cluster upgrade step %d/%d to %s completed", u.step, u.steps, clusterSpec.Version), "")
+	return nil
+}
+
+func (u Step) Rollback(ctx context.Context,
+	status cluster.Status,
+	clusterSpec *v1alpha1.ClusterSpec,
+	ou linux.OSUtil) error {
+	return nil
+}
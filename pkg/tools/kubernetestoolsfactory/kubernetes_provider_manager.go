@@ -55,11 +55,12 @@ func (k *KubeManager) KubernetesClusterUpgradeManager(ctx context.Context, versi
 			Version:     version,
 		},
 	}
-	err := kubeadmTool.Upgrade(ctx, upgradeCluster)
+	operation, err := kubeadmTool.Upgrade(ctx, upgradeCluster)
 	if err != nil {
 		logger.Error(err, "cluster upgrade failed")
 		return err
 	}
+	logger = logger.WithValues("operation", operation.GetId())
 	// wait for upgrade to be completed
 	waitCount := 0
 	for status.GetStatus(ctx).GetPhase() != constants.ClusterPhaseProvisioned && waitCount < constants.ClusterUpgradeWaitDuration {
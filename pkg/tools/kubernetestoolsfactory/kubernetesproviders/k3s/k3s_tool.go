@@ -2,18 +2,12 @@ package k3s
 
 import (
 	"context"
-	"errors"
-	"go.uber.org/multierr"
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/cluster"
 	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/operations"
 	kubernetestool "kubeclusteragent/pkg/tools/kubernetestoolsfactory/kubernetesproviders"
-)
-
-var (
-	defaultPodNetwork     = "10.42.0.0/16"
-	defaultServiceNetwork = "10.43.0.0/16"
+	"kubeclusteragent/pkg/util/validation"
 )
 
 type K3sTool struct {
@@ -44,7 +38,7 @@ func (t *K3sTool) IsInitialized(ctx context.Context) bool {
 		clusterStatus.Phase != constants.ClusterPhaseFailed
 }
 
-func (t *K3sTool) Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) error {
+func (t *K3sTool) Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) (*v1alpha1.Operation, error) {
 	var options []operations.Option
 	if t.dryRun {
 		options = append(options, operations.DryRun())
@@ -54,14 +48,10 @@ func (t *K3sTool) Install(ctx context.Context, request *v1alpha1.CreateClusterRe
 		Tasks:               buildInstallOptions(options...),
 		SpecValidationError: t.validateSpec(request.Spec),
 	}
-	err := defaultKubernetesTool.Install(ctx, request)
-	if err != nil {
-		return err
-	}
-	return nil
+	return defaultKubernetesTool.Install(ctx, request)
 }
 
-func (t *K3sTool) Reset(ctx context.Context) error {
+func (t *K3sTool) Reset(ctx context.Context) (*v1alpha1.Operation, error) {
 	var options []operations.Option
 	if t.dryRun {
 		options = append(options, operations.DryRun())
@@ -69,11 +59,7 @@ func (t *K3sTool) Reset(ctx context.Context) error {
 	defaultKubernetesTool = &kubernetestool.DefaultKubernetesProvider{
 		ClusterStatus: t.clusterStatus,
 	}
-	err := defaultKubernetesTool.Reset(ctx)
-	if err != nil {
-		return err
-	}
-	return nil
+	return defaultKubernetesTool.Reset(ctx)
 }
 
 func (t *K3sTool) Cluster(ctx context.Context) (*v1alpha1.Cluster, error) {
@@ -97,7 +83,7 @@ func (t *K3sTool) ResetConfig(ctx context.Context) error {
 	return defaultKubernetesTool.ResetConfig(ctx)
 }
 
-func (t *K3sTool) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) error {
+func (t *K3sTool) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) (*v1alpha1.Operation, error) {
 	var options []operations.Option
 	if t.dryRun {
 		options = append(options, operations.DryRun())
@@ -107,38 +93,54 @@ func (t *K3sTool) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterR
 		Tasks:               buildUpgradeOptions(options...),
 		SpecValidationError: t.validateSpec(request.Spec),
 	}
-	err := defaultKubernetesTool.Upgrade(ctx, request)
-	if err != nil {
-		return err
-	}
-	return nil
+	return defaultKubernetesTool.Upgrade(ctx, request)
 }
 
 func (t *K3sTool) GetCerts(ctx context.Context) (*v1alpha1.ClusterCertificatesResponse, error) {
 	return nil, nil
 }
 
-func (t *K3sTool) validateSpec(spec *v1alpha1.ClusterSpec) error {
-	var err error
-	if spec.Version == "" {
-		spec.Version = "latest"
+func (t *K3sTool) Resume(ctx context.Context, checkpoint *cluster.Checkpoint, rollback bool) error {
+	var options []operations.Option
+	if t.dryRun {
+		options = append(options, operations.DryRun())
 	}
-	if spec.Networking == nil {
-		spec.Networking = new(v1alpha1.ClusterNetworking)
+	tasks, err := buildOperationOptions(checkpoint.Operation, options...)
+	if err != nil {
+		return err
 	}
-	if spec.Networking.PodSubnet == "" {
-		spec.Networking.PodSubnet = defaultPodNetwork
+	defaultKubernetesTool = &kubernetestool.DefaultKubernetesProvider{
+		ClusterStatus: t.clusterStatus,
+		Tasks:         tasks,
+	}
+	return defaultKubernetesTool.Resume(ctx, checkpoint, rollback)
+}
+
+// Plan returns the tasks of the operation along with the changes each would make to the node. The
+// spec is validated the way the operation validates it.
+func (t *K3sTool) Plan(ctx context.Context, operation string, clusterSpec *v1alpha1.ClusterSpec) ([]*v1alpha1.PlannedTask, error) {
+	tasks, err := buildOperationOptions(operation)
+	if err != nil {
+		return nil, err
 	}
-	if spec.Networking.SvcSubnet == "" {
-		spec.Networking.SvcSubnet = defaultServiceNetwork
+	var specValidationError error
+	switch operation {
+	case constants.OperationInstall:
+		specValidationError = t.validateSpec(clusterSpec)
+	case constants.OperationUpgrade:
+		// the upgrade applies the spec defaults without rejecting the spec
+		_ = t.validateSpec(clusterSpec)
 	}
-	if spec.DisableWorkloads == nil {
-		disableWorkload := false
-		spec.DisableWorkloads = &disableWorkload
+	defaultKubernetesTool = &kubernetestool.DefaultKubernetesProvider{
+		ClusterStatus:       t.clusterStatus,
+		Tasks:               tasks,
+		SpecValidationError: specValidationError,
 	}
+	return defaultKubernetesTool.Plan(ctx, operation, clusterSpec)
+}
 
-	if spec.Version == "" {
-		err = multierr.Append(err, errors.New("no K3s version found"))
-	}
-	return err
+// validateSpec sets the defaults of the spec and checks it.
+func (t *K3sTool) validateSpec(spec *v1alpha1.ClusterSpec) error {
+	validation.SetDefaults(spec)
+	return validation.ValidateClusterSpec(spec)
 }
@@ -1,6 +1,9 @@
 package k3s
 
 import (
+	"errors"
+	"fmt"
+	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/operations"
 	"kubeclusteragent/pkg/task"
 	k3sInstall "kubeclusteragent/pkg/task/install/k3s"
@@ -34,6 +37,18 @@ func buildUpgradeOptions(options ...operations.Option) operations.TaskDetails {
 	return current
 }
 
+// buildOperationOptions returns the task details of the given operation type, it is used to
+// rebuild an interrupted operation from its checkpoint and to plan an operation.
+func buildOperationOptions(operation string, options ...operations.Option) (operations.TaskDetails, error) {
+	switch operation {
+	case constants.OperationInstall:
+		return buildInstallOptions(options...), nil
+	case constants.OperationUpgrade:
+		return buildUpgradeOptions(options...), nil
+	}
+	return operations.TaskDetails{}, fmt.Errorf("operation %q: %w", operation, errors.ErrUnsupported)
+}
+
 //func buildResetOptions(options ...operations.Option) operations.TaskDetails {
 //	current := operations.TaskDetails{
 //		PreTasks: []task.Task{
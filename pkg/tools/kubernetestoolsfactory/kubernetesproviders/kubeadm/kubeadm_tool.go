@@ -2,11 +2,12 @@ package kubeadm
 
 import (
 	"context"
-	"errors"
-	"go.uber.org/multierr"
 	"kubeclusteragent/pkg/cluster"
 	"kubeclusteragent/pkg/constants"
 	kubernetestool "kubeclusteragent/pkg/tools/kubernetestoolsfactory/kubernetesproviders"
+	"kubeclusteragent/pkg/util/k8sversion"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"kubeclusteragent/pkg/util/validation"
 
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/operations"
@@ -39,7 +40,7 @@ func (t *KubeadmTool) IsInitialized(ctx context.Context) bool {
 
 }
 
-func (t *KubeadmTool) Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) error {
+func (t *KubeadmTool) Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) (*v1alpha1.Operation, error) {
 	var options []operations.Option
 	if t.dryRun {
 		options = append(options, operations.DryRun())
@@ -49,14 +50,10 @@ func (t *KubeadmTool) Install(ctx context.Context, request *v1alpha1.CreateClust
 		Tasks:               buildInstallOptions(options...),
 		SpecValidationError: t.validateSpec(request.Spec),
 	}
-	err := defaultKubernetesTool.Install(ctx, request)
-	if err != nil {
-		return err
-	}
-	return nil
+	return defaultKubernetesTool.Install(ctx, request)
 }
 
-func (t *KubeadmTool) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) error {
+func (t *KubeadmTool) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) (*v1alpha1.Operation, error) {
 	var options []operations.Option
 	if t.dryRun {
 		options = append(options, operations.DryRun())
@@ -65,15 +62,15 @@ func (t *KubeadmTool) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClus
 		ClusterStatus:       t.clusterStatus,
 		Tasks:               buildUpgradeOptions(options...),
 		SpecValidationError: t.validateSpec(request.Spec),
+		UpgradeChain: func(clusterSpec *v1alpha1.ClusterSpec, versions []string) operations.TaskDetails {
+			return buildChainedUpgradeOptions(clusterSpec, versions, options...)
+		},
+		Versions: k8sversion.NewCatalog(linux.NewLiveExec()),
 	}
-	err := defaultKubernetesTool.Upgrade(ctx, request)
-	if err != nil {
-		return err
-	}
-	return nil
+	return defaultKubernetesTool.Upgrade(ctx, request)
 }
 
-func (t *KubeadmTool) Reset(ctx context.Context) error {
+func (t *KubeadmTool) Reset(ctx context.Context) (*v1alpha1.Operation, error) {
 	var options []operations.Option
 	if t.dryRun {
 		options = append(options, operations.DryRun())
@@ -82,11 +79,7 @@ func (t *KubeadmTool) Reset(ctx context.Context) error {
 		ClusterStatus: t.clusterStatus,
 		Tasks:         buildResetOptions(options...),
 	}
-	err := defaultKubernetesTool.Reset(ctx)
-	if err != nil {
-		return err
-	}
-	return nil
+	return defaultKubernetesTool.Reset(ctx)
 }
 
 func (t *KubeadmTool) Cluster(ctx context.Context) (*v1alpha1.Cluster, error) {
@@ -126,42 +119,51 @@ func (t *KubeadmTool) GetCerts(ctx context.Context) (*v1alpha1.ClusterCertificat
 	return defaultKubernetesTool.GetCerts(ctx)
 }
 
+func (t *KubeadmTool) Resume(ctx context.Context, checkpoint *cluster.Checkpoint, rollback bool) error {
+	var options []operations.Option
+	if t.dryRun {
+		options = append(options, operations.DryRun())
+	}
+	tasks, err := buildOperationOptions(checkpoint.Operation, options...)
+	if err != nil {
+		return err
+	}
+	if checkpoint.Operation == constants.OperationUpgrade && len(checkpoint.Steps) > 0 && checkpoint.ClusterSpec != nil {
+		// the checkpoint of an upgrade through several minor versions records its steps
+		tasks = buildChainedUpgradeOptions(checkpoint.ClusterSpec, checkpoint.Steps, options...)
+	}
+	defaultKubernetesTool = &kubernetestool.DefaultKubernetesProvider{
+		ClusterStatus: t.clusterStatus,
+		Tasks:         tasks,
+	}
+	return defaultKubernetesTool.Resume(ctx, checkpoint, rollback)
+}
+
+// Plan returns the tasks of the operation along with the changes each would make to the node. The
+// spec is validated the way the operation validates it.
+func (t *KubeadmTool) Plan(ctx context.Context, operation string, clusterSpec *v1alpha1.ClusterSpec) ([]*v1alpha1.PlannedTask, error) {
+	tasks, err := buildOperationOptions(operation)
+	if err != nil {
+		return nil, err
+	}
+	var specValidationError error
+	switch operation {
+	case constants.OperationInstall:
+		specValidationError = t.validateSpec(clusterSpec)
+	case constants.OperationUpgrade:
+		// the upgrade applies the spec defaults without rejecting the spec
+		_ = t.validateSpec(clusterSpec)
+	}
+	defaultKubernetesTool = &kubernetestool.DefaultKubernetesProvider{
+		ClusterStatus:       t.clusterStatus,
+		Tasks:               tasks,
+		SpecValidationError: specValidationError,
+	}
+	return defaultKubernetesTool.Plan(ctx, operation, clusterSpec)
+}
+
+// validateSpec sets the defaults of the spec and checks it.
 func (t *KubeadmTool) validateSpec(spec *v1alpha1.ClusterSpec) error {
-	var err error
-	if spec.Networking == nil {
-		err = multierr.Append(err, errors.New("networking object is nil"))
-	} else {
-		if spec.Networking.PodSubnet == "" {
-			spec.Networking.PodSubnet = constants.DefaultPodNetwork
-		}
-		if spec.Networking.SvcSubnet == "" {
-			spec.Networking.SvcSubnet = constants.DefaultServiceNetwork
-		}
-		if spec.DisableWorkloads == nil {
-			disableWorkload := false
-			spec.DisableWorkloads = &disableWorkload
-		}
-		if spec.Networking.CniManifestURL == "" {
-			if spec.Networking.CniVersion == "" || spec.Networking.CniName == "" {
-				err = multierr.Append(err, errors.New("cni details is missing cni name and version is mandatory"))
-			}
-		}
-	}
-	if spec.Version == "" {
-		// get k8s version form the distro available in the VM
-		err = multierr.Append(err, errors.New("no Kubernetes version"))
-	}
-	if spec.ClusterRuntime != nil &&
-		spec.ClusterRuntime.CustomiseClusterRuntime {
-		if spec.ClusterRuntime.ClusterCri.PrivateRegistryFQDN == "" {
-			err = multierr.Append(err, errors.New("cluster runtime is true, so private registry FQDN is mandatory"))
-		}
-		if spec.ClusterRuntime.ClusterCri.RegistryAuth.IsAuthRequired {
-			if spec.ClusterRuntime.ClusterCri.RegistryAuth.Username == "" ||
-				spec.ClusterRuntime.ClusterCri.RegistryAuth.Password == "" {
-				err = multierr.Append(err, errors.New("cluster runtime private registry authentication is true , then username and password is mandatory"))
-			}
-		}
-	}
-	return err
+	validation.SetDefaults(spec)
+	return validation.ValidateClusterSpec(spec)
 }
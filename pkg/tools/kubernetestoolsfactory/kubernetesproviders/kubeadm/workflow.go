@@ -1,6 +1,10 @@
 package kubeadm
 
 import (
+	"errors"
+	"fmt"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
 	"kubeclusteragent/pkg/operations"
 	"kubeclusteragent/pkg/task"
 	kubeadmCerts "kubeclusteragent/pkg/task/certs/kubeadm"
@@ -10,6 +14,8 @@ import (
 	"kubeclusteragent/pkg/task/patch"
 	kubeadmUpgrade "kubeclusteragent/pkg/task/upgrade/kubeadm"
 	"kubeclusteragent/pkg/util/osutility/linux"
+
+	"google.golang.org/protobuf/proto"
 )
 
 func buildInstallOptions(options ...operations.Option) operations.TaskDetails {
@@ -32,6 +38,20 @@ func buildInstallOptions(options ...operations.Option) operations.TaskDetails {
 			//	kubeadmCreate.NewInstallCSI(),
 			kubeadmCreate.NewCurrentUserKubeconfig(),
 		},
+		// the node preparation runs alongside the package installation, the packages are installed
+		// one task after the other as apt holds a lock. The CNI and the kubeconfig of the current
+		// user only need the control plane.
+		Dependencies: map[string][]string{
+			"cluster-prerequisites":     {},
+			"containerd-install":        {},
+			"prepare-containerd":        {"containerd-install"},
+			"install-binaries":          {"containerd-install"},
+			"remove-controlplane-taint": {},
+			"workload-schedule":         {"remove-controlplane-taint"},
+			"install-cni":               {},
+			"node-readiness":            {"install-cni"},
+			"current-user-kubeconfig":   {},
+		},
 		OsUtil: linux.New(),
 	}
 	for _, o := range options {
@@ -48,11 +68,15 @@ func buildUpgradeOptions(options ...operations.Option) operations.TaskDetails {
 			common.NewLoadContainerdImages(),
 			common.NewCoreDNSBackup(),
 		},
+		// kubeadm is upgraded before kubeadm upgrade apply, kubelet and kubectl after it and kubelet is
+		// restarted on the new binary
 		Tasks: []task.Task{
 			common.NewNodeReady(),
+			kubeadmUpgrade.NewUpgradeKubeadm(),
 			kubeadmUpgrade.NewUpgradeCluster()},
 
 		PostTasks: []task.Task{
+			kubeadmUpgrade.NewUpgradeKubelet(),
 			common.NewKubeletReload(),
 			common.NewNodeReady(),
 			common.NewCoreDNSRestore(),
@@ -69,6 +93,22 @@ func buildUpgradeOptions(options ...operations.Option) operations.TaskDetails {
 	return current
 }
 
+// buildChainedUpgradeOptions returns the tasks upgrading the cluster through the versions, one kubeadm
+// upgrade per version. Each step records its version in the status and the audit log once completed.
+func buildChainedUpgradeOptions(clusterSpec *v1alpha1.ClusterSpec, versions []string, options ...operations.Option) operations.TaskDetails {
+	steps := make([]operations.Step, 0, len(versions))
+	for i, version := range versions {
+		stepSpec := proto.Clone(clusterSpec).(*v1alpha1.ClusterSpec)
+		stepSpec.Version = version
+		steps = append(steps, operations.Step{
+			ClusterSpec: stepSpec,
+			TaskDetails: buildUpgradeOptions(options...),
+			Done:        kubeadmUpgrade.NewUpgradeStep(i+1, len(versions)),
+		})
+	}
+	return operations.Steps(steps...)
+}
+
 func buildResetOptions(options ...operations.Option) operations.TaskDetails {
 	current := operations.TaskDetails{
 		Tasks: []task.Task{
@@ -98,3 +138,19 @@ func buildCertsRotationOptions(options ...operations.Option) operations.TaskDeta
 
 	return current
 }
+
+// buildOperationOptions returns the task details of the given operation type, it is used to
+// rebuild an interrupted operation from its checkpoint and to plan an operation.
+func buildOperationOptions(operation string, options ...operations.Option) (operations.TaskDetails, error) {
+	switch operation {
+	case constants.OperationInstall:
+		return buildInstallOptions(options...), nil
+	case constants.OperationUpgrade:
+		return buildUpgradeOptions(options...), nil
+	case constants.OperationReset:
+		return buildResetOptions(options...), nil
+	case constants.OperationResetCerts:
+		return buildCertsRotationOptions(options...), nil
+	}
+	return operations.TaskDetails{}, fmt.Errorf("operation %q: %w", operation, errors.ErrUnsupported)
+}
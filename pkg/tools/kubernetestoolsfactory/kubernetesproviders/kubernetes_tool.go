@@ -10,43 +10,51 @@ import (
 	"kubeclusteragent/pkg/operations"
 	"kubeclusteragent/pkg/tools/metricstool"
 	"kubeclusteragent/pkg/util/conditions"
+	errorutil "kubeclusteragent/pkg/util/error"
+	"kubeclusteragent/pkg/util/k8sversion"
 	"kubeclusteragent/pkg/util/log/log"
 	"kubeclusteragent/pkg/util/metrcis"
 	"kubeclusteragent/pkg/util/osutility/linux"
+	"kubeclusteragent/pkg/util/validation"
 	"os"
+	"strings"
 	"time"
+
+	"google.golang.org/grpc/codes"
 )
 
+// errNotInitialized is returned by the requests that need a provisioned cluster.
+var errNotInitialized = errorutil.New(codes.FailedPrecondition, constants.ReasonClusterNotInitialized, "cluster is not initialized")
+
 type DefaultKubernetesProvider struct {
 	ClusterStatus       cluster.Status
 	dryRun              bool
 	metricsTool         metricstool.PrometheusMetricsTool
 	Tasks               operations.TaskDetails
 	SpecValidationError error
+	// UpgradeChain returns the tasks upgrading the cluster through the versions, one upgrade per
+	// version. Upgrades of more than one minor version are rejected without it.
+	UpgradeChain func(clusterSpec *v1alpha1.ClusterSpec, versions []string) operations.TaskDetails
+	// Versions lists the Kubernetes versions the cluster upgrades through
+	Versions VersionCatalog
+}
+
+// VersionCatalog lists the Kubernetes versions available to the node.
+type VersionCatalog interface {
+	List(ctx context.Context, minors ...string) ([]k8sversion.Available, error)
 }
 
 type KubernetesProviderFactory interface {
 	IsInitialized(ctx context.Context) bool
-	Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) error
-	Reset(ctx context.Context) error
+	Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) (*v1alpha1.Operation, error)
+	Reset(ctx context.Context) (*v1alpha1.Operation, error)
 	Cluster(ctx context.Context) (*v1alpha1.Cluster, error)
 	Config(context.Context) ([]byte, error)
 	ResetConfig(context.Context) error
-	Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) error
+	Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) (*v1alpha1.Operation, error)
 	GetCerts(ctx context.Context) (*v1alpha1.ClusterCertificatesResponse, error)
-}
-
-func (t *DefaultKubernetesProvider) ExecutionInProgress(ctx context.Context) bool {
-	clusterStatus := t.ClusterStatus.GetStatus(ctx)
-	switch clusterStatus.Phase {
-	case constants.ClusterPhaseProvisioning:
-		return true
-	case constants.ClusterPhaseKubeConfigResetting:
-		return true
-	case constants.ClusterPhaseDeleting:
-		return true
-	}
-	return false
+	Resume(ctx context.Context, checkpoint *cluster.Checkpoint, rollback bool) error
+	Plan(ctx context.Context, operation string, clusterSpec *v1alpha1.ClusterSpec) ([]*v1alpha1.PlannedTask, error)
 }
 
 func NewDefaultKubernetesInstallTool(clusterStatus cluster.Status, dryRun bool) *DefaultKubernetesProvider {
@@ -62,7 +70,8 @@ func (t *DefaultKubernetesProvider) IsInitializedForUpgrade(ctx context.Context)
 	if clusterStatus == nil {
 		return false
 	}
-	return clusterStatus.Phase == constants.ClusterPhaseProvisioned || clusterStatus.Phase == constants.ClusterPhaseFailed
+	return clusterStatus.Phase == constants.ClusterPhaseProvisioned || clusterStatus.Phase == constants.ClusterPhaseFailed ||
+		clusterStatus.Phase == constants.ClusterPhaseCancelled
 }
 
 func (t *DefaultKubernetesProvider) IsInitialized(ctx context.Context) bool {
@@ -74,48 +83,66 @@ func (t *DefaultKubernetesProvider) IsInitialized(ctx context.Context) bool {
 	return clusterStatus.Phase == constants.ClusterPhaseProvisioned
 }
 
-func (t *DefaultKubernetesProvider) Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) error {
+func (t *DefaultKubernetesProvider) Install(ctx context.Context, request *v1alpha1.CreateClusterRequest) (*v1alpha1.Operation, error) {
 	var metricsResponseCode, auditMessage, auditReason, status string
 	var startTime time.Time
-	clusterStatus := t.ClusterStatus.GetStatus(ctx)
 	if request.Spec.ClusterType == "" {
 		request.Spec.ClusterType = constants.DefaultKubernetesTool
 	}
+	installer := operations.NewOperation("install cluster", constants.OperationInstall, t.ClusterStatus, request.Spec, t.Tasks)
+	// the state is only read once the lock is held, an operation in progress rejects the request
+	if err := installer.Lock(ctx); err != nil {
+		cluster.SetAuditLog(ctx, constants.OperationInstall, request.Spec.ClusterType, request.Spec.Version, status, "Cluster installation rejected", err.Error())
+		return nil, err
+	}
+	defer installer.Abandon(ctx)
+	clusterStatus := t.ClusterStatus.GetStatus(ctx)
 	defer func() {
 		t.metricsTool.MetricsLabels = []string{request.Spec.ClusterType, request.Spec.Version, metricsResponseCode, "POST", "api/v1alpha1/cluster"}
 		t.metricsTool.PopulateToPrometheusMetrics(startTime)
-		cluster.SetAuditLog(ctx, "Install", request.Spec.ClusterType, request.Spec.Version, status, auditMessage, auditReason)
+		cluster.SetAuditLog(ctx, constants.OperationInstall, request.Spec.ClusterType, request.Spec.Version, status, auditMessage, auditReason)
 		t.ClusterStatus.SetStatus(ctx, clusterStatus)
 	}()
 	if t.IsInitialized(ctx) {
 		auditMessage = "Cluster is already initialized"
-		return fmt.Errorf("cluster already initialized")
+		return nil, errorutil.New(codes.AlreadyExists, constants.ReasonClusterAlreadyInitialized, "cluster already initialized")
 	}
-	auditMessage = "Cluster installation is in progress"
-	clusterStatus.Phase = constants.ClusterPhaseProvisioning
-
 	if err := t.SpecValidationError; err != nil {
 		auditMessage = "Cluster validation failed"
 
 		metricsResponseCode = metrcis.ClusterAlreadyInitialized
-		return fmt.Errorf("validate cluster spec: %w", err)
+		return nil, fmt.Errorf("validate cluster spec: %w", err)
+	}
+	operation, err := installer.Register(ctx)
+	if err != nil {
+		auditMessage = "Cluster installation rejected"
+		return nil, err
 	}
-	t.ClusterStatus.SetSpec(ctx, request.Spec)
+	ctx = cluster.WithOperationID(ctx, operation.GetId())
+	auditMessage = "Cluster installation is in progress"
+	clusterStatus.Phase = constants.ClusterPhaseProvisioning
+	cluster.AcceptSpec(ctx, t.ClusterStatus, request.Spec, operation)
+	// the operation outlives the request, it must not be cancelled along with it
+	ctx = context.WithoutCancel(ctx)
 	go func() {
+		var rollbacks []*v1alpha1.TaskRollback
 		defer func() {
 			t.metricsTool.MetricsLabels = []string{request.Spec.ClusterType, request.Spec.Version, metricsResponseCode, "POST", "api/v1alpha1/cluster"}
 			t.metricsTool.PopulateToPrometheusMetrics(startTime)
 			t.ClusterStatus.SetStatus(ctx, clusterStatus)
-			cluster.SetAuditLog(ctx, "Install", request.Spec.ClusterType, request.Spec.Version, clusterStatus.GetPhase(), auditMessage, auditReason)
+			cluster.SetAuditLogWithRollbacks(ctx, constants.OperationInstall, request.Spec.ClusterType, request.Spec.Version, clusterStatus.GetPhase(), auditMessage, auditReason, rollbacks)
 		}()
 		logger := log.From(ctx)
-		taskDetails := t.Tasks
-		installer := operations.NewOperation("install cluster", t.ClusterStatus, request.Spec, taskDetails)
 		if err := installer.Run(ctx); err != nil {
 			auditMessage = "Cluster installation failed"
 			auditReason = err.Error()
+			rollbacks = installer.RollbackResults()
 			logger.Error(err, "cluster failed while installation")
 			clusterStatus.Phase = constants.ClusterPhaseFailed
+			if errors.Is(err, operations.ErrCancelled) {
+				auditMessage = "Cluster installation cancelled"
+				clusterStatus.Phase = constants.ClusterPhaseCancelled
+			}
 			metricsResponseCode = metrcis.ClusterFailed
 			conditions.MarkFalse(clusterStatus,
 				v1alpha1.ConditionType_InstallSuccess,
@@ -130,41 +157,58 @@ func (t *DefaultKubernetesProvider) Install(ctx context.Context, request *v1alph
 
 	}()
 
-	return nil
+	return operation, nil
 }
 
-func (t *DefaultKubernetesProvider) Reset(ctx context.Context) error {
+func (t *DefaultKubernetesProvider) Reset(ctx context.Context) (*v1alpha1.Operation, error) {
 	var metricsResponseCode, auditMessage, auditReason, status string
 	var startTime time.Time
+	resetter := operations.NewOperation("reset cluster", constants.OperationReset, t.ClusterStatus, t.ClusterStatus.GetSpec(ctx), t.Tasks)
+	if err := resetter.Lock(ctx); err != nil {
+		cluster.SetAuditLog(ctx, constants.OperationReset, t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, status, "Cluster reset rejected", err.Error())
+		return nil, err
+	}
+	defer resetter.Abandon(ctx)
 	var clusterStatus = t.ClusterStatus.GetStatus(ctx)
 	defer func() {
 		t.metricsTool.MetricsLabels = []string{t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, metricsResponseCode, "DELETE", "api/v1alpha1/cluster"}
 		t.metricsTool.PopulateToPrometheusMetrics(startTime)
 		t.ClusterStatus.SetStatus(ctx, clusterStatus)
-		cluster.SetAuditLog(ctx, "Reset", t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, status, auditMessage, auditReason)
+		cluster.SetAuditLog(ctx, constants.OperationReset, t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, status, auditMessage, auditReason)
 	}()
 	if clusterStatus != nil && clusterStatus.Phase == constants.ClusterPhaseNotInitialised {
 		auditMessage = "cluster is not initialized,cannot perform delete operation"
-		return fmt.Errorf("cluster is not initialized,cannot perform delete operation")
+		return nil, errorutil.New(codes.FailedPrecondition, constants.ReasonClusterNotInitialized, "cluster is not initialized,cannot perform delete operation")
 	}
+	operation, err := resetter.Register(ctx)
+	if err != nil {
+		auditMessage = "Cluster reset rejected"
+		return nil, err
+	}
+	ctx = cluster.WithOperationID(ctx, operation.GetId())
 	auditMessage = "Cluster is getting sundown"
 	clusterStatus.Phase = constants.ClusterPhaseDeleting
 
+	// the operation outlives the request, it must not be cancelled along with it
+	ctx = context.WithoutCancel(ctx)
 	go func() {
+		var rollbacks []*v1alpha1.TaskRollback
 		defer func() {
 			t.metricsTool.MetricsLabels = []string{t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, metricsResponseCode, "DELETE", "api/v1alpha1/cluster"}
 			t.metricsTool.PopulateToPrometheusMetrics(startTime)
-			cluster.SetAuditLog(ctx, "Reset", t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, clusterStatus.GetPhase(), auditMessage, auditReason)
+			cluster.SetAuditLogWithRollbacks(ctx, constants.OperationReset, t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, clusterStatus.GetPhase(), auditMessage, auditReason, rollbacks)
 		}()
 		logger := log.From(ctx)
-		clusterSpec := t.ClusterStatus.GetSpec(ctx)
-		taskDetails := t.Tasks
-		resetter := operations.NewOperation("reset cluster", t.ClusterStatus, clusterSpec, taskDetails)
 		if err := resetter.Run(ctx); err != nil {
 			metricsResponseCode = metrcis.DeleteFailed
 			auditMessage = "Cluster reset failed"
 			auditReason = err.Error()
+			rollbacks = resetter.RollbackResults()
 			clusterStatus.Phase = constants.ClusterPhaseFailed
+			if errors.Is(err, operations.ErrCancelled) {
+				auditMessage = "Cluster reset cancelled"
+				clusterStatus.Phase = constants.ClusterPhaseCancelled
+			}
 			conditions.MarkFalse(clusterStatus, v1alpha1.ConditionType_DeleteSuccess, constants.ClusterDeleteMessageFailed, constants.ConditionSeverityWarning, auditMessage)
 			logger.Error(err, "Unable to complete reset")
 		} else {
@@ -178,7 +222,7 @@ func (t *DefaultKubernetesProvider) Reset(ctx context.Context) error {
 		}
 	}()
 
-	return nil
+	return operation, nil
 }
 
 func (t *DefaultKubernetesProvider) Cluster(ctx context.Context) (*v1alpha1.Cluster, error) {
@@ -189,12 +233,18 @@ func (t *DefaultKubernetesProvider) Cluster(ctx context.Context) (*v1alpha1.Clus
 			t.ClusterStatus.GetSpec(ctx).Version, responseCode, "GET", "api/v1alpha1/cluster"}
 		t.metricsTool.PopulateToPrometheusMetrics(startTime)
 	}()
+	resourceVersion, err := t.ClusterStatus.GetResourceVersion(ctx)
+	if err != nil {
+		responseCode = metrcis.Failed
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read resource version").WithRetry()
+	}
 	responseCode = metrcis.Success
 	return &v1alpha1.Cluster{
-		ApiVersion: "v1alpha1",
-		Kind:       "Cluster",
-		Spec:       t.ClusterStatus.GetSpec(ctx),
-		Status:     t.ClusterStatus.GetStatus(ctx),
+		ApiVersion:      "v1alpha1",
+		Kind:            "Cluster",
+		Spec:            t.ClusterStatus.GetSpec(ctx),
+		Status:          t.ClusterStatus.GetStatus(ctx),
+		ResourceVersion: resourceVersion,
 	}, nil
 }
 
@@ -208,7 +258,7 @@ func (t *DefaultKubernetesProvider) Config(ctx context.Context) ([]byte, error)
 	}()
 	if !t.IsInitialized(ctx) {
 		metricsResponseCode = metrcis.Failed
-		return nil, errors.New("cluster is not initialized")
+		return nil, errNotInitialized
 	}
 	logger := log.From(ctx).WithValues("ClusterType", clusterSpec.ClusterType, "Version", clusterSpec.Version)
 	logger.Info("Retrieving kubeconfig")
@@ -229,7 +279,7 @@ func (t *DefaultKubernetesProvider) GetCerts(ctx context.Context) (*v1alpha1.Clu
 	}()
 	if !t.IsInitialized(ctx) {
 		metricsResponseCode = metrcis.Failed
-		return nil, errors.New("cluster is not initialized")
+		return nil, errNotInitialized
 	}
 	logger := log.From(ctx).WithValues("ClusterType", clusterSpec.ClusterType, "Version", clusterSpec.Version)
 	logger.Info("Retrieving kubernetes control-plane certificates")
@@ -261,29 +311,35 @@ func (t *DefaultKubernetesProvider) ResetConfig(ctx context.Context) error {
 	logger := log.From(ctx)
 	var metricsResponseCode, auditMessage, auditReason string
 	var startTime time.Time
+	var rollbacks []*v1alpha1.TaskRollback
+	restConfig := operations.NewOperation("reset-certs", constants.OperationResetCerts, t.ClusterStatus, t.ClusterStatus.GetSpec(ctx), t.Tasks)
+	if err := restConfig.Lock(ctx); err != nil {
+		cluster.SetAuditLog(ctx, constants.OperationResetCerts, t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, "", "Certs reset rejected", err.Error())
+		return err
+	}
+	ctx = cluster.WithOperationID(ctx, restConfig.ID())
+	defer restConfig.Abandon(ctx)
 	var clusterStatus = t.ClusterStatus.GetStatus(ctx)
 	defer func() {
 		t.metricsTool.MetricsLabels = []string{t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, metricsResponseCode, "DELETE", "api/v1alpha1/certs"}
 		t.metricsTool.PopulateToPrometheusMetrics(startTime)
 		t.ClusterStatus.SetStatus(ctx, clusterStatus)
-		cluster.SetAuditLog(ctx, "Reset Certs", t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, clusterStatus.GetPhase(), auditMessage, auditReason)
+		cluster.SetAuditLogWithRollbacks(ctx, constants.OperationResetCerts, t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, clusterStatus.GetPhase(), auditMessage, auditReason, rollbacks)
 	}()
 	if !t.IsInitialized(ctx) {
 		auditMessage = "Cluster is not initialized"
 		metricsResponseCode = metrcis.ResetFailed
-		return fmt.Errorf("cluster is not initialized")
+		return errNotInitialized
 	}
 	logger.Info("Resetting kubernetes certificates")
 	clusterStatus.Phase = constants.ClusterPhaseKubeConfigResetting
-	tasks := t.Tasks
-	restConfig := operations.NewOperation("reset-certs", t.ClusterStatus, t.ClusterStatus.GetSpec(ctx), tasks)
 	err := restConfig.Run(ctx)
 	if err != nil {
-		errMsg := fmt.Sprintf("Error resetting certs: %s", err.Error())
 		auditMessage = "Error resetting certs"
 		auditReason = err.Error()
+		rollbacks = restConfig.RollbackResults()
 		metricsResponseCode = metrcis.ResetFailed
-		return fmt.Errorf(errMsg)
+		return fmt.Errorf("Error resetting certs: %w", err)
 	}
 
 	auditMessage = "certs successfully reset"
@@ -293,52 +349,96 @@ func (t *DefaultKubernetesProvider) ResetConfig(ctx context.Context) error {
 	return nil
 }
 
-func (t *DefaultKubernetesProvider) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) error {
+func (t *DefaultKubernetesProvider) Upgrade(ctx context.Context, request *v1alpha1.UpgradeClusterRequest) (*v1alpha1.Operation, error) {
 	var metricsResponseCode, auditMessage, auditReason string
 	var startTime time.Time
 	var currentClusterSpec *v1alpha1.ClusterSpec
+	fromVersion := t.ClusterStatus.GetStatus(ctx).GetKubernetesVersion()
+	steps, err := t.upgradeSteps(ctx, fromVersion, request)
+	if err != nil {
+		cluster.SetAuditLog(ctx, constants.OperationUpgrade, request.Spec.ClusterType, request.Spec.Version, "", "Cluster upgrade rejected", err.Error())
+		return nil, err
+	}
+	tasks := t.Tasks
+	if len(steps) > 1 {
+		tasks = t.UpgradeChain(request.Spec, steps)
+	}
+	upgrader := operations.NewOperation("upgrade cluster", constants.OperationUpgrade, t.ClusterStatus, request.Spec, tasks)
+	if err := upgrader.Lock(ctx); err != nil {
+		cluster.SetAuditLog(ctx, constants.OperationUpgrade, request.Spec.ClusterType, request.Spec.Version, "", "Cluster upgrade rejected", err.Error())
+		return nil, err
+	}
+	defer upgrader.Abandon(ctx)
 	var clusterStatus = t.ClusterStatus.GetStatus(ctx)
 	defer func() {
 		t.metricsTool.MetricsLabels = []string{t.ClusterStatus.GetSpec(ctx).ClusterType, t.ClusterStatus.GetSpec(ctx).Version, metricsResponseCode, "PUT", "api/v1alpha1/cluster"}
 		t.metricsTool.PopulateToPrometheusMetrics(startTime)
-		cluster.SetAuditLog(ctx, "Upgrade", request.Spec.ClusterType, clusterStatus.KubernetesVersion, clusterStatus.GetPhase(), auditMessage, auditReason)
+		cluster.SetAuditLog(ctx, constants.OperationUpgrade, request.Spec.ClusterType, clusterStatus.KubernetesVersion, clusterStatus.GetPhase(), auditMessage, auditReason)
 		t.ClusterStatus.SetStatus(ctx, clusterStatus)
 	}()
 	if !t.IsInitializedForUpgrade(ctx) {
 		auditMessage = "Cluster must be installed properly for upgrade"
 		metricsResponseCode = metrcis.UpgradeFailed
 		clusterStatus.Phase = constants.ClusterPhaseFailed
-		return fmt.Errorf("cluster is not initialized for upgrade")
+		return nil, errorutil.New(codes.FailedPrecondition, constants.ReasonClusterNotInitialized, "cluster is not initialized for upgrade")
+	}
+	if err := cluster.CheckResourceVersion(ctx, t.ClusterStatus, request.ResourceVersion); err != nil {
+		auditMessage = "Cluster upgrade rejected"
+		auditReason = err.Error()
+		metricsResponseCode = metrcis.UpgradeFailed
+		return nil, err
 	}
 	upgradeVersion := request.Spec.Version
 	currentClusterVersion := clusterStatus.KubernetesVersion
-	currentExecution := t.ExecutionInProgress(ctx)
-	if currentExecution {
-		auditMessage = fmt.Sprintf("Currently %v is running, please try after it reaches a terminal state complete or failed", currentExecution)
-		return fmt.Errorf("currently %v is running", currentExecution)
+	if currentClusterVersion != fromVersion {
+		// the upgrade path was computed before the lock, another operation changed the cluster since
+		auditMessage = "Cluster upgrade rejected"
+		auditReason = fmt.Sprintf("cluster version changed from %s to %s", fromVersion, currentClusterVersion)
+		metricsResponseCode = metrcis.UpgradeFailed
+		return nil, errorutil.New(codes.Aborted, constants.ReasonResourceVersionConflict, auditReason).WithRetry()
 	}
+	operation, err := upgrader.Register(ctx)
+	if err != nil {
+		auditMessage = "Cluster upgrade rejected"
+		return nil, err
+	}
+	ctx = cluster.WithOperationID(ctx, operation.GetId())
 	clusterStatus.Phase = constants.ClusterPhaseUpgrading
 	auditMessage = fmt.Sprintf("Cluster upgrade to version %s in progress", upgradeVersion)
+	if len(steps) > 1 {
+		auditMessage = fmt.Sprintf("Cluster upgrade to version %s through %s in progress", upgradeVersion, strings.Join(steps[:len(steps)-1], ", "))
+	}
 	currentClusterSpec = t.ClusterStatus.GetSpec(ctx)
 	currentClusterSpec.Version = request.Spec.Version
-	t.ClusterStatus.SetSpec(ctx, currentClusterSpec)
+	cluster.AcceptSpec(ctx, t.ClusterStatus, currentClusterSpec, operation)
+	// the operation outlives the request, it must not be cancelled along with it
+	ctx = context.WithoutCancel(ctx)
 	go func() {
+		var rollbacks []*v1alpha1.TaskRollback
 		defer func() {
 			t.metricsTool.MetricsLabels = []string{t.ClusterStatus.GetSpec(ctx).ClusterType, request.Spec.Version, metricsResponseCode, "PUT", "api/v1alpha1/cluster"}
 			t.metricsTool.PopulateToPrometheusMetrics(startTime)
-			cluster.SetAuditLog(ctx, "Upgrade", request.Spec.ClusterType, clusterStatus.KubernetesVersion, clusterStatus.GetPhase(), auditMessage, auditReason)
+			cluster.SetAuditLogWithRollbacks(ctx, constants.OperationUpgrade, request.Spec.ClusterType, clusterStatus.KubernetesVersion, clusterStatus.GetPhase(), auditMessage, auditReason, rollbacks)
 			t.ClusterStatus.SetStatus(ctx, clusterStatus)
 		}()
 		logger := log.From(ctx)
-		taskDetails := t.Tasks
-		upgrader := operations.NewOperation("upgrade cluster", t.ClusterStatus, request.Spec, taskDetails)
 		if err := upgrader.Run(ctx); err != nil {
 			logger.Error(err, "Unable to upgrade cluster")
 			auditMessage = fmt.Sprintf("failed to upgrade cluster to  %s", upgradeVersion)
 			auditReason = err.Error()
+			rollbacks = upgrader.RollbackResults()
 			clusterStatus.Phase = constants.ClusterPhaseFailed
+			if errors.Is(err, operations.ErrCancelled) {
+				auditMessage = fmt.Sprintf("Cluster upgrade to %s cancelled", upgradeVersion)
+				clusterStatus.Phase = constants.ClusterPhaseCancelled
+			}
 			metricsResponseCode = metrcis.UpgradeFailed
 			clusterStatus.KubernetesVersion = currentClusterVersion
+			if len(steps) > 1 {
+				// the completed steps are kept, the cluster runs the version of the last one
+				clusterStatus.KubernetesVersion = t.ClusterStatus.GetStatus(ctx).GetKubernetesVersion()
+				auditMessage += fmt.Sprintf(", the cluster stays at %s", clusterStatus.KubernetesVersion)
+			}
 			// this is only a warning condition. Else Phase will just Fail and status won't have clear picture why its in Failed state
 			conditions.MarkFalse(clusterStatus, v1alpha1.ConditionType_UpgradeSuccess, constants.ControlUpgradeMessageFailed, constants.ConditionSeverityWarning, auditMessage)
 		} else {
@@ -348,5 +448,123 @@ func (t *DefaultKubernetesProvider) Upgrade(ctx context.Context, request *v1alph
 			metricsResponseCode = metrcis.UpgradeDone
 		}
 	}()
+	return operation, nil
+}
+
+// upgradeSteps returns the versions the cluster upgrades through to the version of the request, the
+// version of the request alone when kubeadm upgrades to it in one step. An upgrade of more than one
+// minor version is rejected unless the request chains it through the newest release of every minor
+// version in between.
+func (t *DefaultKubernetesProvider) upgradeSteps(ctx context.Context, fromVersion string, request *v1alpha1.UpgradeClusterRequest) ([]string, error) {
+	single := []string{request.Spec.GetVersion()}
+	current, err := k8sversion.Parse(fromVersion)
+	if err != nil {
+		// the cluster version is unknown, kubeadm checks the skew itself
+		return single, nil
+	}
+	target, err := k8sversion.Parse(request.Spec.GetVersion())
+	if err != nil {
+		return single, nil
+	}
+	switch {
+	case target.Compare(current) <= 0:
+		return nil, validation.NewError("spec.version", fmt.Sprintf("%s is not newer than the cluster version %s", target, current))
+	case target.Major != current.Major:
+		return nil, validation.NewError("spec.version", fmt.Sprintf("cannot upgrade from %s to another major version", current))
+	case current.UpgradeableTo(target):
+		return single, nil
+	case !request.GetChainUpgrade():
+		return nil, validation.NewError("spec.version", fmt.Sprintf("cannot upgrade from %s to %s in one step, upgrade to %s first or set chainUpgrade",
+			current, target, k8sversion.Version{Major: current.Major, Minor: current.Minor + 1}.MinorVersion()))
+	case t.UpgradeChain == nil || t.Versions == nil:
+		return nil, validation.NewError("chainUpgrade", fmt.Sprintf("%s clusters cannot be upgraded through several minor versions", request.Spec.GetClusterType()))
+	}
+	versions, err := t.Versions.List(ctx, current.MinorsBetween(target)...)
+	if err != nil {
+		// a source that cannot be read may not be needed, the path tells whether a release is missing
+		log.From(ctx).Error(err, "unable to list some of the available Kubernetes versions")
+	}
+	path, err := k8sversion.UpgradePath(current, target, versions)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.FailedPrecondition, constants.ReasonNoUpgradePath,
+			fmt.Sprintf("cannot upgrade from %s to %s", current, target))
+	}
+	steps := make([]string, 0, len(path))
+	for _, v := range path[:len(path)-1] {
+		steps = append(steps, v.String())
+	}
+	// the last step upgrades to the version as requested
+	return append(steps, request.Spec.GetVersion()), nil
+}
+
+// Resume continues an operation interrupted by an agent restart from its checkpoint, or rolls it back
+// when rollback is set. Tasks must be the task details of the checkpoint operation.
+func (t *DefaultKubernetesProvider) Resume(ctx context.Context, checkpoint *cluster.Checkpoint, rollback bool) error {
+	ctx = cluster.WithOperationID(ctx, checkpoint.OperationID)
+	var auditMessage, auditReason string
+	var rollbacks []*v1alpha1.TaskRollback
+	var clusterStatus = t.ClusterStatus.GetStatus(ctx)
+	clusterSpec := checkpoint.ClusterSpec
+	if clusterSpec == nil {
+		return fmt.Errorf("checkpoint of %s operation %s has no cluster spec", checkpoint.Operation, checkpoint.OperationID)
+	}
+	// the operation outlives the request, it must not be cancelled along with it
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() {
+			t.ClusterStatus.SetStatus(ctx, clusterStatus)
+			cluster.SetAuditLogWithRollbacks(ctx, checkpoint.Operation, clusterSpec.ClusterType, clusterSpec.Version, clusterStatus.GetPhase(), auditMessage, auditReason, rollbacks)
+		}()
+		logger := log.From(ctx).WithValues("operation", checkpoint.Operation, "id", checkpoint.OperationID)
+		resumed := operations.ResumeOperation(checkpoint.Operation, checkpoint, t.ClusterStatus, t.Tasks)
+		if rollback {
+			auditMessage = fmt.Sprintf("Interrupted %s operation rolled back after agent restart", checkpoint.Operation)
+			if err := resumed.Rollback(ctx); err != nil {
+				logger.Error(err, "Unable to rollback interrupted operation")
+				auditReason = err.Error()
+			}
+			rollbacks = resumed.RollbackResults()
+			clusterStatus.Phase = constants.ClusterPhaseFailed
+			return
+		}
+		if err := resumed.Run(ctx); err != nil {
+			logger.Error(err, "Unable to complete resumed operation")
+			auditMessage = fmt.Sprintf("Resumed %s operation failed", checkpoint.Operation)
+			auditReason = err.Error()
+			rollbacks = resumed.RollbackResults()
+			clusterStatus.Phase = constants.ClusterPhaseFailed
+			if errors.Is(err, operations.ErrCancelled) {
+				auditMessage = fmt.Sprintf("Resumed %s operation cancelled", checkpoint.Operation)
+				clusterStatus.Phase = constants.ClusterPhaseCancelled
+			}
+			if len(checkpoint.Steps) > 0 {
+				// the steps completed since the restart are kept
+				clusterStatus.KubernetesVersion = t.ClusterStatus.GetStatus(ctx).GetKubernetesVersion()
+			}
+			return
+		}
+		auditMessage = fmt.Sprintf("Resumed %s operation completed after agent restart", checkpoint.Operation)
+		switch checkpoint.Operation {
+		case constants.OperationReset:
+			clusterStatus.Phase = constants.ClusterPhaseDelete
+			if err := t.ClusterStatus.PurgeAllClusterData(ctx); err != nil {
+				logger.Error(err, "unable to remove the cluster data , agent need to be restarted")
+			}
+		case constants.OperationUpgrade:
+			clusterStatus.Phase = constants.ClusterPhaseProvisioned
+			clusterStatus.KubernetesVersion = clusterSpec.Version
+		default:
+			clusterStatus.Phase = constants.ClusterPhaseProvisioned
+		}
+	}()
 	return nil
 }
+
+// Plan returns the tasks of the operation along with the changes each would make to the node, nothing
+// is changed. Tasks must be the task details of the operation.
+func (t *DefaultKubernetesProvider) Plan(ctx context.Context, operation string, clusterSpec *v1alpha1.ClusterSpec) ([]*v1alpha1.PlannedTask, error) {
+	if err := t.SpecValidationError; err != nil {
+		return nil, fmt.Errorf("validate cluster spec: %w", err)
+	}
+	return operations.Plan(ctx, operation, t.ClusterStatus, clusterSpec, t.Tasks), nil
+}
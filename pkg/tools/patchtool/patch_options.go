@@ -1,26 +1,55 @@
 package patchtool
 
 import (
+	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/operations"
-	"kubeclusteragent/pkg/task"
 	kubeadmCreate "kubeclusteragent/pkg/task/install/kubeadm"
 	"kubeclusteragent/pkg/task/patch"
 	"kubeclusteragent/pkg/util/osutility/linux"
+	"kubeclusteragent/pkg/util/validation"
 )
 
-func buildPatchOptions(options ...operations.Option) operations.TaskDetails {
+var (
+	// cniFields are the fields of the cluster spec applied by installing the CNI again.
+	cniFields = []string{"networking.cniManifestURL", "networking.cniName", "networking.cniVersion", "networking.cilium"}
+	// csiFields are the fields of the cluster spec applied by installing the CSI.
+	csiFields = []string{"storage"}
+	// workloadFields are the fields of the cluster spec applied by scheduling the workloads on the node or not.
+	workloadFields = []string{"disableWorkloads"}
+)
+
+// patchableFields are the fields of the cluster spec a patch changes, a task applies each of them.
+var patchableFields = concat(cniFields, csiFields, workloadFields)
+
+// legacyPatchFields are the fields the patches of older agents applied, whatever changed, their
+// interrupted operations are resumed with the same tasks.
+var legacyPatchFields = []string{"networking", "disableWorkloads"}
+
+// buildPatchOptions returns the tasks applying the changed fields of the cluster spec, named by their
+// path from the spec. previous is the spec the patch replaces, the tasks restore it when rolled back.
+func buildPatchOptions(changed []string, previous *v1alpha1.ClusterSpec, options ...operations.Option) operations.TaskDetails {
 	current := operations.TaskDetails{
-		Tasks: []task.Task{
-			kubeadmCreate.NewInstallCNI(),
-		},
 		OsUtil: linux.New(),
-		PostTasks: []task.Task{
-			patch.UpdateWorkloadScheduler(),
-		},
+	}
+	if validation.Covers(changed, cniFields...) {
+		current.Tasks = append(current.Tasks, patch.NewApplyCNI(previous))
+	}
+	if validation.Covers(changed, csiFields...) {
+		current.Tasks = append(current.Tasks, kubeadmCreate.NewInstallCSI())
+	}
+	if validation.Covers(changed, workloadFields...) {
+		current.PostTasks = append(current.PostTasks, patch.UpdateWorkloadScheduler())
 	}
 	for _, o := range options {
 		o(&current)
 	}
-
 	return current
 }
+
+func concat(fields ...[]string) []string {
+	var all []string
+	for _, f := range fields {
+		all = append(all, f...)
+	}
+	return all
+}
@@ -2,21 +2,34 @@ package patchtool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"kubeclusteragent/pkg/cluster"
 	"kubeclusteragent/pkg/tools/metricstool"
 	"kubeclusteragent/pkg/util/conditions"
+	errorutil "kubeclusteragent/pkg/util/error"
+	"kubeclusteragent/pkg/util/fieldmask"
 	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/validation"
+	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
 	"kubeclusteragent/pkg/constants"
 
 	"kubeclusteragent/gen/go/agent/v1alpha1"
 	"kubeclusteragent/pkg/operations"
 )
 
+// errNotInitializedForPatch is returned by the patches of a cluster that is not provisioned.
+var errNotInitializedForPatch = errorutil.New(codes.FailedPrecondition, constants.ReasonClusterNotInitialized, "cluster is not initialized for patch")
+
 type ClusterConfigurationChange interface {
-	Patch(ctx context.Context, request *v1alpha1.PatchClusterRequest) error
+	Patch(ctx context.Context, request *v1alpha1.PatchClusterRequest) (*v1alpha1.Operation, error)
+	Plan(ctx context.Context, request *v1alpha1.PatchClusterRequest) ([]*v1alpha1.PlannedTask, error)
+	Resume(ctx context.Context, checkpoint *cluster.Checkpoint, rollback bool) error
 }
 
 type ClusterConfigTool struct {
@@ -45,68 +58,200 @@ func (t *ClusterConfigTool) IsInitializedForPatch(ctx context.Context) bool {
 	return false
 }
 
-func (t *ClusterConfigTool) ExecutionInProgress(ctx context.Context) bool {
-	switch t.clusterStatus.GetStatus(ctx).Phase {
-	case constants.ClusterPhaseProvisioning:
-		return true
-	case constants.ClusterPhaseKubeConfigResetting:
-		return true
-	case constants.ClusterPhaseDeleting:
-		return true
-	}
-
-	return false
-}
-
-func (t ClusterConfigTool) Patch(ctx context.Context, request *v1alpha1.PatchClusterRequest) error {
+func (t ClusterConfigTool) Patch(ctx context.Context, request *v1alpha1.PatchClusterRequest) (*v1alpha1.Operation, error) {
 	logger := log.From(ctx).WithName("Patch Configuration")
 	var metricsResponseCode, auditMessage, auditReason string
 	var startTime time.Time
 
+	// the patch is made against the stored spec at its resource version, the operation is aborted when
+	// the spec changes before it holds the lock
+	resourceVersion, err := t.clusterStatus.GetResourceVersion(ctx)
+	if err != nil {
+		return nil, errorutil.Wrap(err, codes.Unavailable, constants.ReasonStateStoreUnavailable, "read resource version").WithRetry()
+	}
+	if request.ResourceVersion != 0 {
+		resourceVersion = request.ResourceVersion
+	}
+	if !t.IsInitializedForPatch(ctx) {
+		cluster.SetAuditLog(ctx, constants.OperationPatch, request.GetSpec().GetClusterType(), request.GetSpec().GetVersion(), "", "Cluster must be installed properly for Patch to take place", "")
+		return nil, errNotInitializedForPatch
+	}
+	previous := t.clusterStatus.GetSpec(ctx)
+	clusterSpec, changes, err := patchedSpec(previous, request)
+	if err != nil {
+		cluster.SetAuditLog(ctx, constants.OperationPatch, request.GetSpec().GetClusterType(), request.GetSpec().GetVersion(), "", "Cluster patch rejected", err.Error())
+		return nil, err
+	}
+	changed := fieldmask.Paths(changes)
+
+	var options []operations.Option
+	if t.dryRun {
+		options = append(options, operations.DryRun())
+	}
+	patcher := operations.NewOperation("patch cluster", constants.OperationPatch, t.clusterStatus, clusterSpec, buildPatchOptions(changed, previous, options...))
+	// the state is only read once the lock is held, an operation in progress rejects the request
+	if err := patcher.Lock(ctx); err != nil {
+		cluster.SetAuditLog(ctx, constants.OperationPatch, clusterSpec.ClusterType, clusterSpec.Version, "", "Cluster patch rejected", err.Error())
+		return nil, err
+	}
+	defer patcher.Abandon(ctx)
 	var clusterStatus = t.clusterStatus.GetStatus(ctx)
 
 	defer func() {
-		t.metricsTool.MetricsLabels = []string{request.Spec.ClusterType, request.Spec.Version, metricsResponseCode, "POST", "api/v1alpha1/cluster"}
+		t.metricsTool.MetricsLabels = []string{clusterSpec.ClusterType, clusterSpec.Version, metricsResponseCode, "POST", "api/v1alpha1/cluster"}
 		t.metricsTool.PopulateToPrometheusMetrics(startTime)
 		t.clusterStatus.SetStatus(ctx, clusterStatus)
-		cluster.SetAuditLog(ctx, "Patch", request.Spec.ClusterType, request.Spec.Version, clusterStatus.GetPhase(), auditMessage, auditReason)
+		cluster.SetAuditLog(ctx, constants.OperationPatch, clusterSpec.ClusterType, clusterSpec.Version, clusterStatus.GetPhase(), auditMessage, auditReason)
 	}()
 	if !t.IsInitializedForPatch(ctx) {
 		auditMessage = "Cluster must be installed properly for Patch to take place"
 
-		return fmt.Errorf("cluster is not initialized for patch")
+		return nil, errNotInitializedForPatch
 	}
-	t.clusterStatus.SetSpec(ctx, request.Spec)
-	currentExecution := t.ExecutionInProgress(ctx)
-	if currentExecution {
-		auditMessage = fmt.Sprintf("Currently %v is running, please try after it reaches a terminal state complete or failed", currentExecution)
+	if err := cluster.CheckResourceVersion(ctx, t.clusterStatus, resourceVersion); err != nil {
+		auditMessage = "Cluster patch rejected"
+		auditReason = err.Error()
+		return nil, err
+	}
+	operation, err := patcher.Register(ctx)
+	if err != nil {
+		auditMessage = "Cluster patch rejected"
+		return nil, err
+	}
+	ctx = cluster.WithOperationID(ctx, operation.GetId())
+	logger.Info("Patching cluster", "changes", changes)
+	auditMessage = fmt.Sprintf("Cluster patch of %s is in progress", strings.Join(changed, ", "))
+	cluster.AcceptSpec(ctx, t.clusterStatus, clusterSpec, operation)
 
-		return fmt.Errorf("currently %v is running", currentExecution)
+	// the operation outlives the request, it must not be cancelled along with it
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		var rollbacks []*v1alpha1.TaskRollback
+		defer func() {
+			t.clusterStatus.SetStatus(ctx, clusterStatus)
+			cluster.SetAuditLogWithRollbacks(ctx, constants.OperationPatch, clusterSpec.ClusterType, clusterSpec.Version, clusterStatus.GetPhase(), auditMessage, auditReason, rollbacks)
+		}()
+		if err := patcher.Run(ctx); err != nil {
+			logger.Error(err, "Unable to patch cluster")
+			auditMessage = "failed to patch cluster"
+			if errors.Is(err, operations.ErrCancelled) {
+				auditMessage = "Cluster patch cancelled"
+			}
+			auditReason = err.Error()
+			rollbacks = patcher.RollbackResults()
+			conditions.MarkFalse(clusterStatus, v1alpha1.ConditionType_PackageReady, constants.PackageReadStatusMessageFailed, clusterStatus.GetPhase(), auditMessage, auditReason)
+		} else {
+			auditMessage = "Cluster is successfully patched"
+			conditions.MarkTrue(clusterStatus, v1alpha1.ConditionType_PackageReady)
+		}
+	}()
+	return operation, nil
+}
+
+// Plan returns the tasks of the patch along with the changes each would make to the node, nothing is
+// changed.
+func (t ClusterConfigTool) Plan(ctx context.Context, request *v1alpha1.PatchClusterRequest) ([]*v1alpha1.PlannedTask, error) {
+	previous := t.clusterStatus.GetSpec(ctx)
+	clusterSpec, changes, err := patchedSpec(previous, request)
+	if err != nil {
+		return nil, err
 	}
+	return operations.Plan(ctx, constants.OperationPatch, t.clusterStatus, clusterSpec, buildPatchOptions(fieldmask.Paths(changes), previous)), nil
+}
 
-	auditMessage = "Cluster patch is in progress"
+// patchedSpec returns the cluster spec the request makes of the current one, along with the fields it
+// changes. A patch changing no field, or a field that cannot be patched, is refused, so is a patched
+// spec that is not valid.
+func patchedSpec(current *v1alpha1.ClusterSpec, request *v1alpha1.PatchClusterRequest) (*v1alpha1.ClusterSpec, []fieldmask.Change, error) {
+	if request.GetSpec() == nil {
+		return nil, nil, validation.NewError("spec", "must be set")
+	}
+	clusterSpec := proto.Clone(request.Spec).(*v1alpha1.ClusterSpec)
+	if len(request.GetUpdateMask().GetPaths()) > 0 {
+		clusterSpec = proto.Clone(current).(*v1alpha1.ClusterSpec)
+		if err := fieldmask.Apply(clusterSpec, request.Spec, request.UpdateMask); err != nil {
+			return nil, nil, validation.NewError("updateMask", err.Error())
+		}
+	}
+	changes := fieldmask.Diff(current, clusterSpec)
+	if len(changes) == 0 {
+		return nil, nil, errorutil.New(codes.FailedPrecondition, constants.ReasonSpecUnchanged, "the patch does not change the cluster spec")
+	}
+	if err := validation.ValidateSpecUpdate(fieldmask.Paths(changes), patchableFields); err != nil {
+		return nil, nil, err
+	}
+	if err := validation.ValidateClusterSpec(clusterSpec); err != nil {
+		return nil, nil, err
+	}
+	return clusterSpec, changes, nil
+}
 
+// Resume continues a patch interrupted by an agent restart from its checkpoint, or rolls it back when rollback is set.
+func (t ClusterConfigTool) Resume(ctx context.Context, checkpoint *cluster.Checkpoint, rollback bool) error {
+	ctx = cluster.WithOperationID(ctx, checkpoint.OperationID)
+	logger := log.From(ctx).WithName("Patch Configuration").WithValues("id", checkpoint.OperationID)
+	var auditMessage, auditReason string
+	var rollbacks []*v1alpha1.TaskRollback
+	var clusterStatus = t.clusterStatus.GetStatus(ctx)
+	clusterSpec := checkpoint.ClusterSpec
+	if clusterSpec == nil {
+		return fmt.Errorf("checkpoint of patch operation %s has no cluster spec", checkpoint.OperationID)
+	}
+	// the operation outlives the request, it must not be cancelled along with it
+	ctx = context.WithoutCancel(ctx)
 	go func() {
 		defer func() {
 			t.clusterStatus.SetStatus(ctx, clusterStatus)
-			cluster.SetAuditLog(ctx, "Patch", request.Spec.ClusterType, request.Spec.Version, clusterStatus.GetPhase(), auditMessage, auditReason)
+			cluster.SetAuditLogWithRollbacks(ctx, constants.OperationPatch, clusterSpec.ClusterType, clusterSpec.Version, clusterStatus.GetPhase(), auditMessage, auditReason, rollbacks)
 		}()
 		var options []operations.Option
 		if t.dryRun {
 			options = append(options, operations.DryRun())
 		}
-		taskDetails := buildPatchOptions(options...)
-		patcher := operations.NewOperation("patch cluster", t.clusterStatus, request.Spec, taskDetails)
+		changed, previous := t.patchedFields(ctx, checkpoint)
+		patcher := operations.ResumeOperation("patch cluster", checkpoint, t.clusterStatus, buildPatchOptions(changed, previous, options...))
+		if rollback {
+			auditMessage = "Interrupted patch rolled back after agent restart"
+			if err := patcher.Rollback(ctx); err != nil {
+				logger.Error(err, "Unable to rollback interrupted patch")
+				auditReason = err.Error()
+			}
+			rollbacks = patcher.RollbackResults()
+			conditions.MarkFalse(clusterStatus, v1alpha1.ConditionType_PackageReady, constants.PackageReadStatusMessageFailed, clusterStatus.GetPhase(), auditMessage, auditReason)
+			return
+		}
 		if err := patcher.Run(ctx); err != nil {
 			logger.Error(err, "Unable to patch cluster")
 			auditMessage = "failed to patch cluster"
+			if errors.Is(err, operations.ErrCancelled) {
+				auditMessage = "Cluster patch cancelled"
+			}
 			auditReason = err.Error()
+			rollbacks = patcher.RollbackResults()
 			conditions.MarkFalse(clusterStatus, v1alpha1.ConditionType_PackageReady, constants.PackageReadStatusMessageFailed, clusterStatus.GetPhase(), auditMessage, auditReason)
 		} else {
-			// TODO check the diff between two structs and apply that to current Cluster Spec
 			auditMessage = "Cluster is successfully patched"
 			conditions.MarkTrue(clusterStatus, v1alpha1.ConditionType_PackageReady)
 		}
 	}()
 	return nil
 }
+
+// patchedFields returns the fields changed by the interrupted patch of the checkpoint, the difference
+// between the spec it accepted and the revision it replaced, along with the spec of that revision. The
+// patches of older agents, which did not record it, changed the legacyPatchFields of an unknown spec.
+func (t ClusterConfigTool) patchedFields(ctx context.Context, checkpoint *cluster.Checkpoint) ([]string, *v1alpha1.ClusterSpec) {
+	revisions, err := t.clusterStatus.ListSpecRevisions(ctx)
+	if err != nil {
+		log.From(ctx).Error(err, "unable to read the cluster spec revisions, resuming the patch with its legacy tasks")
+		return legacyPatchFields, nil
+	}
+	// the revisions are listed most recent first, the one replaced by the patch follows its own
+	for i := 0; i+1 < len(revisions); i++ {
+		if revisions[i].GetOperationId() == checkpoint.OperationID {
+			previous := revisions[i+1].GetSpec()
+			return fieldmask.Paths(fieldmask.Diff(previous, checkpoint.ClusterSpec)), previous
+		}
+	}
+	return legacyPatchFields, nil
+}
@@ -0,0 +1,137 @@
+package patchtool
+
+import (
+	"context"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/cluster"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/db"
+	errorutil "kubeclusteragent/pkg/util/error"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"kubeclusteragent/pkg/util/validation"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func clusterSpec() *v1alpha1.ClusterSpec {
+	disableWorkloads := false
+	return &v1alpha1.ClusterSpec{
+		ClusterType: constants.ClusterTypeKubeadm,
+		Version:     "v1.29.3",
+		Networking: &v1alpha1.ClusterNetworking{
+			PodSubnet:  constants.DefaultPodNetwork,
+			SvcSubnet:  constants.DefaultServiceNetwork,
+			CniName:    constants.CniCalico,
+			CniVersion: "v3.27.0",
+		},
+		DisableWorkloads: &disableWorkloads,
+	}
+}
+
+// taskNames returns the names of the tasks of the patch of the fields.
+func taskNames(changed ...string) []string {
+	options := buildPatchOptions(changed, nil)
+	var names []string
+	for _, task := range append(options.Tasks, options.PostTasks...) {
+		names = append(names, task.Name())
+	}
+	return names
+}
+
+func TestPatchedSpec(t *testing.T) {
+	current := clusterSpec()
+	disableWorkloads := true
+	spec, changes, err := patchedSpec(current, &v1alpha1.PatchClusterRequest{
+		Spec:       &v1alpha1.ClusterSpec{DisableWorkloads: &disableWorkloads},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"disableWorkloads"}},
+	})
+	require.NoError(t, err)
+	require.True(t, spec.GetDisableWorkloads())
+	require.Equal(t, "v3.27.0", spec.Networking.CniVersion)
+	require.Len(t, changes, 1)
+	require.Equal(t, "disableWorkloads", changes[0].Path)
+
+	// without a mask the spec of the request replaces the current one
+	replaced := clusterSpec()
+	replaced.Networking.CniVersion = "v3.28.0"
+	_, changes, err = patchedSpec(current, &v1alpha1.PatchClusterRequest{Spec: replaced})
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Equal(t, "networking.cniVersion", changes[0].Path)
+	require.True(t, proto.Equal(clusterSpec(), current), "the current spec was changed")
+
+	replaced.Networking.PodSubnet = "10.200.0.0/16"
+	_, _, err = patchedSpec(current, &v1alpha1.PatchClusterRequest{Spec: replaced})
+	var invalid *validation.Error
+	require.ErrorAs(t, err, &invalid)
+	require.Len(t, invalid.Violations, 1)
+	require.Equal(t, "spec.networking.podSubnet", invalid.Violations[0].Field)
+
+	_, _, err = patchedSpec(current, &v1alpha1.PatchClusterRequest{Spec: clusterSpec()})
+	var unchanged *errorutil.Error
+	require.ErrorAs(t, err, &unchanged)
+	require.Equal(t, codes.FailedPrecondition, unchanged.Code)
+	require.Equal(t, constants.ReasonSpecUnchanged, unchanged.Reason)
+
+	_, _, err = patchedSpec(current, &v1alpha1.PatchClusterRequest{
+		Spec:       &v1alpha1.ClusterSpec{},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"networking.cniRelease"}},
+	})
+	require.ErrorAs(t, err, &invalid)
+	require.Equal(t, "updateMask", invalid.Violations[0].Field)
+}
+
+func TestBuildPatchOptions(t *testing.T) {
+	require.Equal(t, []string{"apply-cni"}, taskNames("networking.cniVersion"))
+	require.Equal(t, []string{"install-csi", "workload-schedule"}, taskNames("storage.clusterCsi.version", "disableWorkloads"))
+	require.Equal(t, []string{"apply-cni", "workload-schedule"}, taskNames(legacyPatchFields...))
+	require.Empty(t, taskNames())
+}
+
+func TestBuildPatchOptions_RollbackRestoresCNI(t *testing.T) {
+	ctx := context.Background()
+	previous := clusterSpec()
+	previous.Networking.CniManifestURL = "https://example.com/calico-v3.27.0.yaml"
+	patched := clusterSpec()
+	patched.Networking.CniManifestURL = "https://example.com/calico-v3.28.0.yaml"
+
+	rollback := func(previous *v1alpha1.ClusterSpec) []string {
+		recorder := linux.NewRecorder()
+		cni := buildPatchOptions([]string{"networking.cniManifestURL"}, previous).Tasks[0]
+		require.NoError(t, cni.Rollback(ctx, nil, patched, recorder))
+		var commands []string
+		for _, action := range recorder.TakeActions() {
+			commands = append(commands, action.Description)
+		}
+		return commands
+	}
+	// the CNI of the running cluster is never deleted, the one of the replaced spec is applied again
+	commands := rollback(previous)
+	require.Len(t, commands, 1)
+	require.Contains(t, commands[0], "apply -f https://example.com/calico-v3.27.0.yaml")
+	require.Empty(t, rollback(nil))
+}
+
+func TestClusterConfigTool_PatchedFields(t *testing.T) {
+	ctx := context.Background()
+	status, err := cluster.NewLiveStatusWithBackend(ctx, false, db.NewMemoryBackend())
+	require.NoError(t, err)
+	tool := NewClusterConfigInstallTool(status, true)
+	patched := clusterSpec()
+	patched.Networking.CniVersion = "v3.28.0"
+	checkpoint := &cluster.Checkpoint{OperationID: "operation-2", Operation: constants.OperationPatch, ClusterSpec: patched}
+	changed, previous := tool.patchedFields(ctx, checkpoint)
+	require.Equal(t, legacyPatchFields, changed)
+	require.Nil(t, previous)
+
+	cluster.AcceptSpec(ctx, status, clusterSpec(), &v1alpha1.Operation{Id: "operation-1", Type: constants.OperationInstall})
+	cluster.AcceptSpec(ctx, status, patched, &v1alpha1.Operation{Id: "operation-2", Type: constants.OperationPatch})
+	changed, previous = tool.patchedFields(ctx, checkpoint)
+	require.Equal(t, []string{"networking.cniVersion"}, changed)
+	require.Equal(t, "v3.27.0", previous.GetNetworking().GetCniVersion())
+}
@@ -0,0 +1,55 @@
+package auditsink
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FileSink appends the audit entries to a file, one JSON entry per line.
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+var _ Sink = &FileSink{}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+func (s *FileSink) Send(ctx context.Context, entry *v1alpha1.Operations) error {
+	line, err := protojson.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry: %w", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(s.path), constants.DirPerm); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, constants.FileReadWriteAccess)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	// the entry is removed from the spool once Send returns, it must be on disk by then
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,254 @@
+package auditsink
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/log/log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Sink delivers audit entries off the node.
+type Sink interface {
+	// Name identifies the sink, it names its spool file.
+	Name() string
+	// Send delivers the entry, an error means it was not delivered and is sent again later.
+	Send(ctx context.Context, entry *v1alpha1.Operations) error
+}
+
+// Forwarder delivers the audit entries to the sinks. Every entry is first appended to the spool of
+// each sink, a file of the spool directory, and removed from it once the sink has accepted it. The
+// entries are delivered in the order they were published, a sink that fails is retried with the
+// entries left in its spool, including after an agent restart.
+type Forwarder struct {
+	spools []*spool
+	// attempts, backoff, retryInterval and timeout bound the delivery, see constants.AuditSinkAttempts.
+	attempts      int
+	backoff       time.Duration
+	retryInterval time.Duration
+	timeout       time.Duration
+	wg            sync.WaitGroup
+}
+
+// NewForwarder returns a forwarder to the sinks spooling the entries in spoolDirectory, which is
+// created if needed.
+func NewForwarder(spoolDirectory string, sinks ...Sink) (*Forwarder, error) {
+	if err := os.MkdirAll(spoolDirectory, constants.OwnerReadWriteExecute); err != nil {
+		return nil, fmt.Errorf("create audit spool directory: %w", err)
+	}
+	f := &Forwarder{
+		attempts:      constants.AuditSinkAttempts,
+		backoff:       constants.AuditSinkBackoff,
+		retryInterval: constants.AuditSinkRetryInterval,
+		timeout:       constants.AuditSinkTimeout,
+	}
+	names := make(map[string]bool, len(sinks))
+	for _, sink := range sinks {
+		if names[sink.Name()] {
+			return nil, fmt.Errorf("audit sink %s is configured twice", sink.Name())
+		}
+		names[sink.Name()] = true
+		f.spools = append(f.spools, &spool{
+			sink:    sink,
+			path:    filepath.Join(spoolDirectory, sink.Name()+".jsonl"),
+			pending: make(chan struct{}, 1),
+		})
+	}
+	return f, nil
+}
+
+// Start delivers the entries in the background until ctx is done, starting with the entries left in
+// the spools.
+func (f *Forwarder) Start(ctx context.Context) {
+	for _, s := range f.spools {
+		s.notify()
+		f.wg.Add(1)
+		go func(s *spool) {
+			defer f.wg.Done()
+			f.deliver(ctx, s)
+		}(s)
+	}
+}
+
+// Wait returns once the forwarder stopped, after the context given to Start is done.
+func (f *Forwarder) Wait() {
+	f.wg.Wait()
+}
+
+// Publish appends the entry to the spool of every sink. It fails when an entry could not be spooled,
+// the entry is still spooled for the other sinks.
+func (f *Forwarder) Publish(ctx context.Context, entry *v1alpha1.Operations) error {
+	line, err := protojson.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry: %w", err)
+	}
+	var spoolErr error
+	for _, s := range f.spools {
+		if err := s.append(line); err != nil {
+			spoolErr = fmt.Errorf("spool audit entry for %s: %w", s.sink.Name(), err)
+			continue
+		}
+		s.notify()
+	}
+	return spoolErr
+}
+
+// deliver sends the spooled entries of a sink whenever an entry is published, and every retry
+// interval while entries are left.
+func (f *Forwarder) deliver(ctx context.Context, s *spool) {
+	logger := log.From(ctx).WithName("audit-sink").WithValues("sink", s.sink.Name())
+	retry := time.NewTimer(f.retryInterval)
+	defer retry.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.pending:
+		case <-retry.C:
+		}
+		if err := f.drain(ctx, s); err != nil && ctx.Err() == nil {
+			logger.Error(err, "unable to deliver audit entries, they are kept in the spool", "retryIn", f.retryInterval)
+		}
+		retry.Reset(f.retryInterval)
+	}
+}
+
+// drain sends the spooled entries in order and removes the delivered ones from the spool. It stops at
+// the first entry the sink did not accept within the attempts.
+func (f *Forwarder) drain(ctx context.Context, s *spool) error {
+	lines, err := s.read()
+	if err != nil {
+		return err
+	}
+	delivered := 0
+	var sendErr error
+	for _, line := range lines {
+		entry := &v1alpha1.Operations{}
+		if err := protojson.Unmarshal(line, entry); err != nil {
+			// an entry that cannot be read back would block the spool forever
+			log.From(ctx).WithName("audit-sink").Error(err, "dropping unreadable spooled audit entry", "sink", s.sink.Name())
+			delivered++
+			continue
+		}
+		if sendErr = f.send(ctx, s.sink, entry); sendErr != nil {
+			break
+		}
+		delivered++
+	}
+	if err := s.remove(delivered); err != nil {
+		return err
+	}
+	return sendErr
+}
+
+// send tries to deliver the entry up to attempts times, waiting backoff, then twice as long, between
+// the attempts.
+func (f *Forwarder) send(ctx context.Context, sink Sink, entry *v1alpha1.Operations) error {
+	backoff := f.backoff
+	var err error
+	for attempt := 1; attempt <= f.attempts; attempt++ {
+		sendCtx, cancel := context.WithTimeout(ctx, f.timeout)
+		err = sink.Send(sendCtx, entry)
+		cancel()
+		if err == nil || attempt == f.attempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return err
+}
+
+// spool is the file of the entries not yet delivered to a sink, one JSON entry per line.
+type spool struct {
+	sink    Sink
+	path    string
+	mu      sync.Mutex
+	pending chan struct{}
+}
+
+func (s *spool) notify() {
+	select {
+	case s.pending <- struct{}{}:
+	default:
+	}
+}
+
+func (s *spool) append(line []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, constants.FileReadWriteAccess)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func (s *spool) read() ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return splitLines(data), nil
+}
+
+// remove drops the first n entries of the spool. Entries are only appended while they are sent, the
+// first n entries are still the ones that were sent.
+func (s *spool) remove(n int) error {
+	if n == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	lines := splitLines(data)
+	if n > len(lines) {
+		n = len(lines)
+	}
+	remaining := bytes.Join(lines[n:], []byte("\n"))
+	if len(remaining) > 0 {
+		remaining = append(remaining, '\n')
+	}
+	// the spool is replaced at once, an interrupted write never loses the entries left
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, remaining, constants.FileReadWriteAccess); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path)
+}
+
+func splitLines(data []byte) [][]byte {
+	var lines [][]byte
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+			lines = append(lines, append([]byte{}, line...))
+		}
+	}
+	return lines
+}
@@ -0,0 +1,160 @@
+package auditsink
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// webhookServer records the operation ids it received, it fails the requests while failing is set.
+type webhookServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failing  int
+	received []string
+}
+
+func newWebhookServer(t *testing.T, failing int) *webhookServer {
+	w := &webhookServer{failing: failing}
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if w.failing != 0 {
+			if w.failing > 0 {
+				w.failing--
+			}
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var entry map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.received = append(w.received, entry["operationId"].(string))
+	}))
+	t.Cleanup(w.Close)
+	return w
+}
+
+func (w *webhookServer) ids() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string{}, w.received...)
+}
+
+func newTestForwarder(t *testing.T, spoolDirectory string, sinks ...Sink) *Forwarder {
+	f, err := NewForwarder(spoolDirectory, sinks...)
+	require.NoError(t, err)
+	f.backoff = time.Millisecond
+	f.retryInterval = 10 * time.Millisecond
+	return f
+}
+
+func entry(id string) *v1alpha1.Operations {
+	return &v1alpha1.Operations{
+		Operation:    "Upgrade",
+		Status:       "Provisioned",
+		Message:      "Cluster is successfully upgraded to v1.29.0",
+		OperationId:  id,
+		Caller:       "admin",
+		LastExecuted: timestamppb.New(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)),
+	}
+}
+
+func TestForwarder_Webhook(t *testing.T) {
+	server := newWebhookServer(t, 2)
+	spoolDirectory := t.TempDir()
+	f := newTestForwarder(t, spoolDirectory, NewWebhookSink(server.URL, server.Client()))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		f.Wait()
+	}()
+	f.Start(ctx)
+
+	require.NoError(t, f.Publish(ctx, entry("operation-1")))
+	require.NoError(t, f.Publish(ctx, entry("operation-2")))
+	require.Eventually(t, func() bool {
+		return len(server.ids()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"operation-1", "operation-2"}, server.ids())
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(filepath.Join(spoolDirectory, "webhook.jsonl"))
+		return err == nil && len(data) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestForwarder_SpoolOutlivesTheAgent(t *testing.T) {
+	spoolDirectory := t.TempDir()
+	down := newWebhookServer(t, -1)
+	f := newTestForwarder(t, spoolDirectory, NewWebhookSink(down.URL, down.Client()))
+	ctx, cancel := context.WithCancel(context.Background())
+	f.Start(ctx)
+	require.NoError(t, f.Publish(ctx, entry("operation-1")))
+	require.NoError(t, f.Publish(ctx, entry("operation-2")))
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	f.Wait()
+	require.Empty(t, down.ids())
+
+	// the restarted agent delivers what its previous run could not
+	up := newWebhookServer(t, 0)
+	restarted := newTestForwarder(t, spoolDirectory, NewWebhookSink(up.URL, up.Client()))
+	ctx, cancel = context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		restarted.Wait()
+	}()
+	restarted.Start(ctx)
+	require.Eventually(t, func() bool {
+		return len(up.ids()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"operation-1", "operation-2"}, up.ids())
+}
+
+func TestForwarder_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	f := newTestForwarder(t, t.TempDir(), NewFileSink(path))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		f.Wait()
+	}()
+	f.Start(ctx)
+	require.NoError(t, f.Publish(ctx, entry("operation-1")))
+	require.NoError(t, f.Publish(ctx, entry("operation-2")))
+	require.Eventually(t, func() bool {
+		return len(readLines(t, path)) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	var first map[string]interface{}
+	require.NoError(t, json.Unmarshal(readLines(t, path)[0], &first))
+	require.Equal(t, "operation-1", first["operationId"])
+	require.Equal(t, "admin", first["caller"])
+	require.Equal(t, "Provisioned", first["Status"])
+}
+
+func TestNewForwarder_RejectsSinksConfiguredTwice(t *testing.T) {
+	_, err := NewForwarder(t.TempDir(), NewFileSink("a.jsonl"), NewFileSink("b.jsonl"))
+	require.Error(t, err)
+}
+
+func readLines(t *testing.T, path string) [][]byte {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	return splitLines(data)
+}
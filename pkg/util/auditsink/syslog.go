@@ -0,0 +1,138 @@
+package auditsink
+
+import (
+	"context"
+	"fmt"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// syslogFacility is the log audit facility of RFC 5424.
+	syslogFacility = 13
+	syslogInfo     = 6
+	syslogWarning  = 4
+	syslogAppName  = "kubeclusteragent"
+	// syslogSDID names the structured data of the entries, 32473 is the enterprise number RFC 5424
+	// reserves for documentation and private use.
+	syslogSDID = "audit@32473"
+)
+
+// SyslogSink sends every audit entry as an RFC 5424 message to a syslog server over udp, tcp, with
+// the octet counting framing of RFC 6587, or a unix socket.
+type SyslogSink struct {
+	network  string
+	address  string
+	hostname string
+}
+
+var _ Sink = &SyslogSink{}
+
+// NewSyslogSink returns a sink to the syslog server of the address, udp://host:port, tcp://host:port
+// or unix:///path.
+func NewSyslogSink(address string) (*SyslogSink, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("parse syslog address %q: %w", address, err)
+	}
+	s := &SyslogSink{network: u.Scheme, address: u.Host}
+	switch u.Scheme {
+	case "udp", "tcp":
+		if u.Port() == "" {
+			s.address = net.JoinHostPort(u.Hostname(), "514")
+		}
+	case "unix":
+		s.network, s.address = "unixgram", u.Path
+	default:
+		return nil, fmt.Errorf("syslog address %q must be udp://, tcp:// or unix://", address)
+	}
+	if s.address == "" {
+		return nil, fmt.Errorf("syslog address %q has no host or path", address)
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		s.hostname = hostname
+	}
+	return s, nil
+}
+
+func (s *SyslogSink) Name() string {
+	return "syslog"
+}
+
+func (s *SyslogSink) Send(ctx context.Context, entry *v1alpha1.Operations) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	message := formatSyslog(entry, s.hostname, os.Getpid())
+	if s.network == "tcp" {
+		message = strconv.Itoa(len(message)) + " " + message
+	}
+	_, err = conn.Write([]byte(message))
+	return err
+}
+
+// formatSyslog returns the RFC 5424 message of the entry. The entry fields are sent as structured
+// data, a failed or rejected operation is a warning.
+func formatSyslog(entry *v1alpha1.Operations, hostname string, pid int) string {
+	severity := syslogInfo
+	if entry.GetReason() != "" || entry.GetStatus() == constants.ClusterPhaseFailed || entry.GetStatus() == constants.ClusterPhaseCancelled {
+		severity = syslogWarning
+	}
+	timestamp := "-"
+	if entry.GetLastExecuted() != nil {
+		timestamp = entry.GetLastExecuted().AsTime().UTC().Format("2006-01-02T15:04:05.000000Z07:00")
+	}
+	var sd strings.Builder
+	sd.WriteString("[" + syslogSDID)
+	params := [][2]string{
+		{"operation", entry.GetOperation()},
+		{"operationId", entry.GetOperationId()},
+		{"caller", entry.GetCaller()},
+		{"status", entry.GetStatus()},
+		{"reason", entry.GetReason()},
+		{"clusterType", entry.GetClusterType()},
+		{"version", entry.GetCurrentVersion()},
+	}
+	for _, param := range params {
+		if param[1] != "" {
+			sd.WriteString(" " + param[0] + `="` + escapeSDValue(param[1]) + `"`)
+		}
+	}
+	sd.WriteString("]")
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s %s %s",
+		syslogFacility*8+severity, timestamp, syslogHeaderField(hostname, 255), syslogAppName, pid,
+		syslogHeaderField(entry.GetOperation(), 32), sd.String(), entry.GetMessage())
+}
+
+// syslogHeaderField returns the value as a header field, printable ASCII without spaces of at most
+// limit characters, - when empty.
+func syslogHeaderField(value string, limit int) string {
+	field := strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return -1
+		}
+		return r
+	}, value)
+	if len(field) > limit {
+		field = field[:limit]
+	}
+	if field == "" {
+		return "-"
+	}
+	return field
+}
+
+func escapeSDValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
@@ -0,0 +1,48 @@
+package auditsink
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSyslog(t *testing.T) {
+	e := entry("operation-1")
+	require.Equal(t,
+		`<110>1 2024-05-01T10:00:00.000000Z node kubeclusteragent 42 Upgrade [audit@32473 operation="Upgrade" operationId="operation-1" caller="admin" status="Provisioned"] Cluster is successfully upgraded to v1.29.0`,
+		formatSyslog(e, "node", 42))
+
+	e.Operation = "Reset Certs"
+	e.Status = "Failed"
+	e.Reason = `kubeadm "certs renew" failed]`
+	require.Equal(t,
+		`<108>1 2024-05-01T10:00:00.000000Z - kubeclusteragent 42 ResetCerts [audit@32473 operation="Reset Certs" operationId="operation-1" caller="admin" status="Failed" reason="kubeadm \"certs renew\" failed\]"] Cluster is successfully upgraded to v1.29.0`,
+		formatSyslog(e, "", 42))
+}
+
+func TestSyslogSink_UDP(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	sink, err := NewSyslogSink("udp://" + listener.LocalAddr().String())
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Send(context.Background(), entry("operation-1")))
+	buffer := make([]byte, 4096)
+	require.NoError(t, listener.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := listener.ReadFrom(buffer)
+	require.NoError(t, err)
+	require.Contains(t, string(buffer[:n]), `operationId="operation-1"`)
+	require.Regexp(t, `^<110>1 `, string(buffer[:n]))
+}
+
+func TestNewSyslogSink(t *testing.T) {
+	sink, err := NewSyslogSink("tcp://syslog.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "syslog.example.com:514", sink.address)
+	_, err = NewSyslogSink("http://syslog.example.com")
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package auditsink
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// WebhookSink posts every audit entry as a JSON document to a URL, any 2xx response accepts it.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+var _ Sink = &WebhookSink{}
+
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &WebhookSink{url: url, client: client}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Send(ctx context.Context, entry *v1alpha1.Operations) error {
+	body, err := protojson.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry: %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("audit webhook responded %s", response.Status)
+	}
+	return nil
+}
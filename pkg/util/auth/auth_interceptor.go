@@ -20,16 +20,46 @@ func CreateAuthInterceptor(jwtManager *JWTManager) *AuthInterceptor {
 	return &AuthInterceptor{jwtManager, accessRules}
 }
 
+// CreateAuthInterceptorForMethods authorizes only the requests of the full methods given, the other
+// requests are let through without a token.
+func CreateAuthInterceptorForMethods(jwtManager *JWTManager, methods ...string) *AuthInterceptor {
+	allRules := getAccessRules()
+	accessRules := make(map[string][]string, len(methods))
+	for _, method := range methods {
+		if roles, ok := allRules[method]; ok {
+			accessRules[method] = roles
+		}
+	}
+	return &AuthInterceptor{jwtManager, accessRules}
+}
+
 func getAccessRules() map[string][]string {
 	rules := make(map[string][]string)
 	base := "/agent.v1alpha1.AgentAPI/"
 	rules[base+"GetCluster"] = []string{"admin", "view"}
+	rules[base+"WatchCluster"] = []string{"admin", "view"}
 	rules[base+"CreateCluster"] = []string{"admin"}
 	rules[base+"UpgradeCluster"] = []string{"admin"}
 	rules[base+"PatchCluster"] = []string{"admin"}
 	rules[base+"DeleteCluster"] = []string{"admin"}
 	rules[base+"GetKubeconfig"] = []string{"admin", "view"}
 	rules[base+"ResetKubeconfig"] = []string{"admin"}
+	rules[base+"GetOperation"] = []string{"admin", "view"}
+	rules[base+"ListOperations"] = []string{"admin", "view"}
+	rules[base+"CancelOperation"] = []string{"admin"}
+	rules[base+"PlanCluster"] = []string{"admin", "view"}
+	rules[base+"ValidateCluster"] = []string{"admin", "view"}
+	rules[base+"ListAvailableVersions"] = []string{"admin", "view"}
+	rules[base+"GetUpgradePaths"] = []string{"admin", "view"}
+	rules[base+"ExecuteScript"] = []string{"admin"}
+	rules[base+"ListSpecRevisions"] = []string{"admin", "view"}
+	rules[base+"RevertClusterSpec"] = []string{"admin"}
+	rules[base+"ExportState"] = []string{"admin"}
+	rules[base+"ImportState"] = []string{"admin"}
+	rules[base+"AuditHistory"] = []string{"admin", "view"}
+	rules[base+"ResetCerts"] = []string{"admin"}
+	rules[base+"GetCerts"] = []string{"admin", "view"}
+	rules[base+"GetReconcilerRequest"] = []string{"admin", "view"}
 	return rules
 }
 
@@ -39,41 +69,71 @@ func (interceptor *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		req interface{},
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
-		err = interceptor.authorize(ctx, info.FullMethod)
+		claims, err := interceptor.authorize(ctx, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
+		if claims != nil {
+			ctx = ContextWithClaims(ctx, claims)
+		}
 		return handler(ctx, req)
 	}
 }
 
-func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string) error {
+func (interceptor *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		claims, err := interceptor.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		if claims != nil {
+			ss = &claimsStream{ServerStream: ss, ctx: ContextWithClaims(ss.Context(), claims)}
+		}
+		return handler(srv, ss)
+	}
+}
+
+// claimsStream is a server stream whose context carries the claims of the caller.
+type claimsStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *claimsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (interceptor *AuthInterceptor) authorize(ctx context.Context, method string) (*UserClaims, error) {
 	logger := log.From(ctx).WithName("AuthInterceptor")
 	allowedRoles, present := interceptor.accessRules[method]
 	if !present {
-		return nil
+		return nil, nil
 	}
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		logger.Info("metadata not found in the incoming request")
-		return status.Errorf(codes.Unauthenticated, "metadata not found")
+		return nil, status.Errorf(codes.Unauthenticated, "metadata not found")
 	}
 	values := md["authorization"]
 	if len(values) == 0 {
 		logger.Info("access token not found in the incoming request")
-		return status.Errorf(codes.Unauthenticated, "access token not found")
+		return nil, status.Errorf(codes.Unauthenticated, "access token not found")
 	}
 	accessToken := values[0]
 	claims, err := interceptor.jwtManager.VerifyToken(accessToken)
 	if err != nil {
 		logger.Error(err, "access token invalid")
-		return status.Errorf(codes.Unauthenticated, "access token invalid: %v", err)
+		return nil, status.Errorf(codes.Unauthenticated, "access token invalid: %v", err)
 	}
 	for _, role := range allowedRoles {
 		if role == claims.Role {
-			return nil
+			return claims, nil
 		}
 	}
 	logger.Info("user does not have privilege to access the API")
-	return status.Errorf(codes.PermissionDenied, "user does not have privilege to access the API")
+	return nil, status.Errorf(codes.PermissionDenied, "user does not have privilege to access the API")
 }
@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type claimsKey struct{}
+
+// ContextWithClaims returns a copy of ctx carrying the verified claims of the caller.
+func ContextWithClaims(ctx context.Context, claims *UserClaims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the verified claims of the caller, nil when the request was not authorized.
+func ClaimsFromContext(ctx context.Context) *UserClaims {
+	claims, _ := ctx.Value(claimsKey{}).(*UserClaims)
+	return claims
+}
+
+// Caller returns who made the request of ctx: the user of its verified access token, otherwise the
+// client address forwarded by the gateway or the address of the peer. It is empty when none is known.
+func Caller(ctx context.Context) string {
+	if claims := ClaimsFromContext(ctx); claims != nil && claims.Username != "" {
+		return claims.Username
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// the gateway appends the address of its own client to the addresses forwarded to it
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			if client := strings.TrimSpace(strings.Split(forwarded[0], ",")[0]); client != "" {
+				return client
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"kubeclusteragent/pkg/util/osutility/linux"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -119,38 +120,35 @@ func GetImageVersionForCleanup() string {
 	return "v1.26.5"
 }
 
+// LoadContainerdImages decompresses the control-plane image archives shipped with the agent and
+// imports them into containerd.
 func LoadContainerdImages(ctx context.Context, ou linux.OSUtil, logger logr.Logger) error {
-	err := os.Chdir(imagePath)
+	files, err := os.ReadDir(imagePath)
 	if err != nil {
 		return err
 	}
 	logger.Info("deleting current .tar files")
-	_, _, err = ou.Exec().Command(ctx, "sh", nil, []string{"-c", "rm -rf *.tar"}...)
+	_, _, err = ou.Exec().Command(ctx, "sh", nil, []string{"-c", fmt.Sprintf("rm -rf %s/*.tar", imagePath)}...)
 	if err != nil {
 		return err
 	}
 	logger.Info("decompressing image tar.gz files")
-	_, status, err := ou.Exec().Command(ctx, "sh", nil, []string{"-c", "gzip --decompress *.tar.gz"}...)
+	_, status, err := ou.Exec().Command(ctx, "sh", nil, []string{"-c", fmt.Sprintf("gzip --decompress %s/*.tar.gz", imagePath)}...)
 	if err != nil {
 		return err
 	}
 	logger.Info("command to decompress tar.gz file ran successfully", "status", status)
-	files, err := os.ReadDir(imagePath)
-	if err != nil {
-		return err
-	}
 	for _, file := range files {
-		if strings.HasSuffix(file.Name(), ".tar") {
-			logger.Info("importing ", file.Name(), "to containerd")
-			_, data, err := ou.Exec().Command(ctx, "ctr", nil, []string{"-n=k8s.io", "images", "import", file.Name()}...)
-			if err != nil {
-				return err
-			}
-			logger.Info("successfully imported", file.Name(), "to containerd", "status", data)
+		if !strings.HasSuffix(file.Name(), ".tar.gz") {
+			continue
 		}
-	}
-	if err != nil {
-		return err
+		image := filepath.Join(imagePath, strings.TrimSuffix(file.Name(), ".gz"))
+		logger.Info("importing ", image, "to containerd")
+		_, data, err := ou.Exec().Command(ctx, "ctr", nil, []string{"-n=k8s.io", "images", "import", image}...)
+		if err != nil {
+			return err
+		}
+		logger.Info("successfully imported", image, "to containerd", "status", data)
 	}
 	return nil
 }
@@ -0,0 +1,159 @@
+package db
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ArchiveFormatVersion is the version of the layout of the archives written by this agent.
+const ArchiveFormatVersion = 1
+
+// ErrInvalidArchive is returned when an archive cannot be read, was written in an unknown format or
+// does not match its checksum.
+var ErrInvalidArchive = errors.New("invalid state archive")
+
+// Archive is a copy of the tables of the state store, written as gzip compressed JSON.
+type Archive struct {
+	FormatVersion int `json:"formatVersion"`
+	// SchemaVersion is the schema version of the store the tables were copied from.
+	SchemaVersion int       `json:"schemaVersion"`
+	CreateTime    time.Time `json:"createTime"`
+	// Checksum is the hex encoded SHA-256 of the JSON encoding of Tables.
+	Checksum string             `json:"checksum"`
+	Tables   map[string][]Entry `json:"tables"`
+}
+
+// NewArchive copies every table of the transaction, except the excluded ones, to an archive.
+func NewArchive(tx Tx, exclude ...string) (*Archive, error) {
+	schemaVersion, err := readSchemaVersion(tx)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := tx.Tables()
+	if err != nil {
+		return nil, err
+	}
+	a := &Archive{
+		FormatVersion: ArchiveFormatVersion,
+		SchemaVersion: schemaVersion,
+		CreateTime:    time.Now().UTC(),
+		Tables:        make(map[string][]Entry, len(tables)),
+	}
+	for _, table := range tables {
+		if contains(exclude, table) {
+			continue
+		}
+		err := tx.ForEach(table, func(key, value []byte) error {
+			a.Tables[table] = append(a.Tables[table], Entry{Key: string(key), Value: append([]byte{}, value...)})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if a.Checksum, err = a.checksum(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// ReadArchive reads an archive written by Marshal, it fails with ErrInvalidArchive unless the
+// archive is in a known format and its tables match its checksum.
+func ReadArchive(data []byte) (*Archive, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	a := &Archive{}
+	if err := json.Unmarshal(content, a); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	if a.FormatVersion != ArchiveFormatVersion {
+		return nil, fmt.Errorf("%w: format version %d, this agent reads version %d", ErrInvalidArchive, a.FormatVersion, ArchiveFormatVersion)
+	}
+	checksum, err := a.checksum()
+	if err != nil {
+		return nil, err
+	}
+	if checksum != a.Checksum {
+		return nil, fmt.Errorf("%w: checksum %s does not match the content, %s", ErrInvalidArchive, a.Checksum, checksum)
+	}
+	return a, nil
+}
+
+// Marshal returns the archive as gzip compressed JSON.
+func (a *Archive) Marshal() ([]byte, error) {
+	content, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("marshal state archive: %w", err)
+	}
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write(content); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Restore replaces the tables of the transaction with the ones of the archive. The kept tables are
+// left as they are, whether or not the archive holds them.
+func (a *Archive) Restore(tx Tx, keep ...string) error {
+	tables, err := tx.Tables()
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if contains(keep, table) {
+			continue
+		}
+		if err := tx.DeleteTable(table); err != nil {
+			return err
+		}
+	}
+	for table, entries := range a.Tables {
+		if contains(keep, table) {
+			continue
+		}
+		for _, entry := range entries {
+			if err := tx.Put(table, entry.Key, entry.Value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checksum returns the checksum of the tables, the JSON encoding of a map sorts its keys and the
+// entries of a table are in key order, the same tables always have the same checksum.
+func (a *Archive) checksum() (string, error) {
+	content, err := json.Marshal(a.Tables)
+	if err != nil {
+		return "", fmt.Errorf("marshal state archive tables: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive(t *testing.T) {
+	source := NewMemoryBackend()
+	require.NoError(t, source.Put(DBMetadataTableName, schemaVersionKey, []byte("2")))
+	require.NoError(t, source.Put("cluster", "spec", []byte("exported")))
+	require.NoError(t, source.Put("lock", "lock", []byte("local")))
+
+	var archive *Archive
+	require.NoError(t, source.View(func(tx Tx) error {
+		var err error
+		archive, err = NewArchive(tx, "lock")
+		return err
+	}))
+	require.Equal(t, 2, archive.SchemaVersion)
+	require.NotContains(t, archive.Tables, "lock")
+	data, err := archive.Marshal()
+	require.NoError(t, err)
+
+	read, err := ReadArchive(data)
+	require.NoError(t, err)
+	require.Equal(t, archive.Checksum, read.Checksum)
+
+	target := NewMemoryBackend()
+	require.NoError(t, target.Put("cluster", "stale", []byte("replaced")))
+	require.NoError(t, target.Put("lock", "lock", []byte("kept")))
+	require.NoError(t, target.Update(func(tx Tx) error {
+		return read.Restore(tx, "lock")
+	}))
+	entries, err := target.List("cluster")
+	require.NoError(t, err)
+	require.Equal(t, []Entry{{Key: "spec", Value: []byte("exported")}}, entries)
+	value, err := target.Get("lock", "lock")
+	require.NoError(t, err)
+	require.Equal(t, []byte("kept"), value)
+	version, err := Store{Backend: target}.SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+}
+
+func TestReadArchive_Invalid(t *testing.T) {
+	archive := &Archive{FormatVersion: ArchiveFormatVersion, Tables: map[string][]Entry{"cluster": {{Key: "spec", Value: []byte("exported")}}}}
+	var err error
+	archive.Checksum, err = archive.checksum()
+	require.NoError(t, err)
+
+	_, err = ReadArchive([]byte("not an archive"))
+	require.ErrorIs(t, err, ErrInvalidArchive)
+
+	tampered := *archive
+	tampered.Tables = map[string][]Entry{"cluster": {{Key: "spec", Value: []byte("tampered")}}}
+	_, err = ReadArchive(mustMarshal(t, &tampered))
+	require.ErrorIs(t, err, ErrInvalidArchive)
+
+	newer := *archive
+	newer.FormatVersion = ArchiveFormatVersion + 1
+	_, err = ReadArchive(mustMarshal(t, &newer))
+	require.ErrorIs(t, err, ErrInvalidArchive)
+
+	_, err = ReadArchive(mustMarshal(t, archive))
+	require.NoError(t, err)
+}
+
+func mustMarshal(t *testing.T, archive *Archive) []byte {
+	data, err := archive.Marshal()
+	require.NoError(t, err)
+	return data
+}
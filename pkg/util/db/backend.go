@@ -0,0 +1,371 @@
+package db
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Backend keeps the values of the state store by table and key.
+type Backend interface {
+	// Get returns a copy of the value of the key, nil when the key or the table does not exist.
+	Get(table, key string) ([]byte, error)
+	Put(table, key string, value []byte) error
+	Delete(table, key string) error
+	// List returns the entries of the table in key order.
+	List(table string) ([]Entry, error)
+	// Update runs fn in a read-write transaction, nothing fn changed is kept when it fails.
+	Update(fn func(tx Tx) error) error
+	// View runs fn in a read-only transaction.
+	View(fn func(tx Tx) error) error
+	Close() error
+}
+
+// Entry is a key of a table and its value.
+type Entry struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
+
+// Tx is a transaction over the tables of the store.
+type Tx interface {
+	// Get returns a copy of the value of the key, nil when the key or the table does not exist.
+	Get(table, key string) []byte
+	Put(table, key string, value []byte) error
+	Delete(table, key string) error
+	// ForEach calls fn for every key of the table in key order, fn must not change the table.
+	ForEach(table string, fn func(key, value []byte) error) error
+	// DeleteTable removes the table and every key it holds.
+	DeleteTable(table string) error
+	// Tables returns the names of the tables holding keys, sorted.
+	Tables() ([]string, error)
+}
+
+var defaultBackend = struct {
+	sync.Mutex
+	backend Backend
+}{}
+
+// Open opens the bbolt state store at path and makes it the default backend of the stores.
+func Open(path string) (Backend, error) {
+	backend, err := NewBoltBackend(path)
+	if err != nil {
+		return nil, err
+	}
+	SetDefault(backend)
+	return backend, nil
+}
+
+// SetDefault makes the backend the default one of the stores, the previous one is not closed.
+func SetDefault(backend Backend) {
+	defaultBackend.Lock()
+	defer defaultBackend.Unlock()
+	defaultBackend.backend = backend
+}
+
+// Default returns the default backend of the stores. Unless another one has been set, it is the
+// bbolt state store at its default location, opened on first use. A store that cannot be opened
+// is returned as a backend failing every call.
+func Default() Backend {
+	defaultBackend.Lock()
+	defer defaultBackend.Unlock()
+	if defaultBackend.backend == nil {
+		backend, err := NewBoltBackend(DBFilePath)
+		if err != nil {
+			return &failedBackend{err: err}
+		}
+		defaultBackend.backend = backend
+	}
+	return defaultBackend.backend
+}
+
+type boltBackend struct {
+	db *bolt.DB
+}
+
+var _ Backend = &boltBackend{}
+
+// NewBoltBackend opens the bbolt database at path, its directory is created if needed.
+func NewBoltBackend(path string) (Backend, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	boltDB, err := bolt.Open(path, FilePermission, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBackend{db: boltDB}, nil
+}
+
+func (b *boltBackend) Get(table, key string) ([]byte, error) {
+	var value []byte
+	err := b.View(func(tx Tx) error {
+		value = tx.Get(table, key)
+		return nil
+	})
+	return value, err
+}
+
+func (b *boltBackend) Put(table, key string, value []byte) error {
+	return b.Update(func(tx Tx) error {
+		return tx.Put(table, key, value)
+	})
+}
+
+func (b *boltBackend) Delete(table, key string) error {
+	return b.Update(func(tx Tx) error {
+		return tx.Delete(table, key)
+	})
+}
+
+func (b *boltBackend) List(table string) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	err := b.View(func(tx Tx) error {
+		return tx.ForEach(table, func(key, value []byte) error {
+			entries = append(entries, Entry{Key: string(key), Value: append([]byte{}, value...)})
+			return nil
+		})
+	})
+	return entries, err
+}
+
+func (b *boltBackend) Update(fn func(tx Tx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+func (b *boltBackend) View(fn func(tx Tx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+func (b *boltBackend) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+var _ Tx = &boltTx{}
+
+func (t *boltTx) Get(table, key string) []byte {
+	b := t.tx.Bucket([]byte(table))
+	if b == nil {
+		return nil
+	}
+	v := b.Get([]byte(key))
+	if v == nil {
+		return nil
+	}
+	// values are only valid for the life of the transaction
+	return append([]byte{}, v...)
+}
+
+func (t *boltTx) Put(table, key string, value []byte) error {
+	b, err := t.tx.CreateBucketIfNotExists([]byte(table))
+	if err != nil {
+		return err
+	}
+	return b.Put([]byte(key), value)
+}
+
+func (t *boltTx) Delete(table, key string) error {
+	b := t.tx.Bucket([]byte(table))
+	if b == nil {
+		return nil
+	}
+	return b.Delete([]byte(key))
+}
+
+func (t *boltTx) ForEach(table string, fn func(key, value []byte) error) error {
+	b := t.tx.Bucket([]byte(table))
+	if b == nil {
+		return nil
+	}
+	return b.ForEach(fn)
+}
+
+func (t *boltTx) DeleteTable(table string) error {
+	err := t.tx.DeleteBucket([]byte(table))
+	if errors.Is(err, bolt.ErrBucketNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (t *boltTx) Tables() ([]string, error) {
+	var tables []string
+	err := t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		if k, _ := b.Cursor().First(); k != nil {
+			tables = append(tables, string(name))
+		}
+		return nil
+	})
+	return tables, err
+}
+
+// memoryBackend keeps the tables in memory, it is meant for tests and dry runs.
+type memoryBackend struct {
+	mu     sync.RWMutex
+	tables map[string]map[string][]byte
+}
+
+var _ Backend = &memoryBackend{}
+
+// NewMemoryBackend returns an empty backend kept in memory.
+func NewMemoryBackend() Backend {
+	return &memoryBackend{tables: make(map[string]map[string][]byte)}
+}
+
+func (m *memoryBackend) Get(table, key string) ([]byte, error) {
+	var value []byte
+	err := m.View(func(tx Tx) error {
+		value = tx.Get(table, key)
+		return nil
+	})
+	return value, err
+}
+
+func (m *memoryBackend) Put(table, key string, value []byte) error {
+	return m.Update(func(tx Tx) error {
+		return tx.Put(table, key, value)
+	})
+}
+
+func (m *memoryBackend) Delete(table, key string) error {
+	return m.Update(func(tx Tx) error {
+		return tx.Delete(table, key)
+	})
+}
+
+func (m *memoryBackend) List(table string) ([]Entry, error) {
+	entries := make([]Entry, 0)
+	err := m.View(func(tx Tx) error {
+		return tx.ForEach(table, func(key, value []byte) error {
+			entries = append(entries, Entry{Key: string(key), Value: append([]byte{}, value...)})
+			return nil
+		})
+	})
+	return entries, err
+}
+
+// Update runs fn against a copy of the tables, the copy replaces them once fn succeeds.
+func (m *memoryBackend) Update(fn func(tx Tx) error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	tx := &memoryTx{tables: make(map[string]map[string][]byte, len(m.tables))}
+	for table, values := range m.tables {
+		tx.tables[table] = make(map[string][]byte, len(values))
+		for key, value := range values {
+			tx.tables[table][key] = value
+		}
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	m.tables = tx.tables
+	return nil
+}
+
+func (m *memoryBackend) View(fn func(tx Tx) error) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return fn(&memoryTx{tables: m.tables, readOnly: true})
+}
+
+func (m *memoryBackend) Close() error {
+	return nil
+}
+
+// errReadOnly is returned when a read-only transaction is asked to change a table.
+var errReadOnly = errors.New("transaction is read-only")
+
+type memoryTx struct {
+	tables   map[string]map[string][]byte
+	readOnly bool
+}
+
+var _ Tx = &memoryTx{}
+
+func (t *memoryTx) Get(table, key string) []byte {
+	value, ok := t.tables[table][key]
+	if !ok {
+		return nil
+	}
+	return append([]byte{}, value...)
+}
+
+func (t *memoryTx) Put(table, key string, value []byte) error {
+	if t.readOnly {
+		return errReadOnly
+	}
+	if t.tables[table] == nil {
+		t.tables[table] = make(map[string][]byte)
+	}
+	// the stored value is never changed in place, a copy of the tables can share it
+	t.tables[table][key] = append([]byte{}, value...)
+	return nil
+}
+
+func (t *memoryTx) Delete(table, key string) error {
+	if t.readOnly {
+		return errReadOnly
+	}
+	delete(t.tables[table], key)
+	return nil
+}
+
+func (t *memoryTx) ForEach(table string, fn func(key, value []byte) error) error {
+	keys := make([]string, 0, len(t.tables[table]))
+	for key := range t.tables[table] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := fn([]byte(key), t.tables[table][key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *memoryTx) DeleteTable(table string) error {
+	if t.readOnly {
+		return errReadOnly
+	}
+	delete(t.tables, table)
+	return nil
+}
+
+func (t *memoryTx) Tables() ([]string, error) {
+	var tables []string
+	for table, values := range t.tables {
+		if len(values) > 0 {
+			tables = append(tables, table)
+		}
+	}
+	sort.Strings(tables)
+	return tables, nil
+}
+
+// failedBackend fails every call with the error that prevented the backend from opening.
+type failedBackend struct {
+	err error
+}
+
+var _ Backend = &failedBackend{}
+
+func (f *failedBackend) Get(table, key string) ([]byte, error)     { return nil, f.err }
+func (f *failedBackend) Put(table, key string, value []byte) error { return f.err }
+func (f *failedBackend) Delete(table, key string) error            { return f.err }
+func (f *failedBackend) List(table string) ([]Entry, error)        { return nil, f.err }
+func (f *failedBackend) Update(fn func(tx Tx) error) error         { return f.err }
+func (f *failedBackend) View(fn func(tx Tx) error) error           { return f.err }
+func (f *failedBackend) Close() error                              { return f.err }
@@ -0,0 +1,82 @@
+package db
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackend(t *testing.T) {
+	backends := map[string]func(t *testing.T) Backend{
+		"bolt": func(t *testing.T) Backend {
+			backend, err := NewBoltBackend(filepath.Join(t.TempDir(), "store", DBName))
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, backend.Close())
+			})
+			return backend
+		},
+		"memory": func(t *testing.T) Backend {
+			return NewMemoryBackend()
+		},
+	}
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			backend := newBackend(t)
+
+			value, err := backend.Get("table", "missing")
+			require.NoError(t, err)
+			require.Nil(t, value)
+
+			require.NoError(t, backend.Put("table", "b", []byte("2")))
+			require.NoError(t, backend.Put("table", "a", []byte("1")))
+			require.NoError(t, backend.Put("other", "c", []byte("3")))
+			value, err = backend.Get("table", "a")
+			require.NoError(t, err)
+			require.Equal(t, []byte("1"), value)
+
+			entries, err := backend.List("table")
+			require.NoError(t, err)
+			require.Equal(t, []Entry{{Key: "a", Value: []byte("1")}, {Key: "b", Value: []byte("2")}}, entries)
+			require.NoError(t, backend.View(func(tx Tx) error {
+				tables, err := tx.Tables()
+				require.Equal(t, []string{"other", "table"}, tables)
+				return err
+			}))
+
+			// a failing transaction changes nothing
+			err = backend.Update(func(tx Tx) error {
+				require.NoError(t, tx.Put("table", "a", []byte("changed")))
+				require.NoError(t, tx.DeleteTable("other"))
+				return errors.New("failed")
+			})
+			require.Error(t, err)
+			value, err = backend.Get("table", "a")
+			require.NoError(t, err)
+			require.Equal(t, []byte("1"), value)
+			value, err = backend.Get("other", "c")
+			require.NoError(t, err)
+			require.Equal(t, []byte("3"), value)
+
+			require.NoError(t, backend.Update(func(tx Tx) error {
+				if err := tx.Delete("table", "a"); err != nil {
+					return err
+				}
+				return tx.DeleteTable("other")
+			}))
+			entries, err = backend.List("table")
+			require.NoError(t, err)
+			require.Equal(t, []Entry{{Key: "b", Value: []byte("2")}}, entries)
+			entries, err = backend.List("other")
+			require.NoError(t, err)
+			require.Empty(t, entries)
+
+			require.Error(t, backend.View(func(tx Tx) error {
+				return tx.Put("table", "a", []byte("1"))
+			}))
+			require.NoError(t, backend.Delete("table", "missing"))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const schemaVersionKey = "schemaVersion"
+
+// ErrNewerSchema is returned when the store was written by an agent with a newer schema version,
+// reading it could silently drop or misread the state that agent wrote.
+var ErrNewerSchema = errors.New("state store was written by a newer agent")
+
+// Migration moves the store from the schema version before it to Version. A migration is never
+// changed once released, a new layout of the state gets a new migration.
+type Migration struct {
+	Version     int
+	Description string
+	Migrate     func(tx Tx) error
+}
+
+// SchemaVersion returns the schema version recorded in the store, 0 for a store written before
+// the schema was versioned.
+func (d Store) SchemaVersion() (int, error) {
+	version := 0
+	err := d.backend().View(func(tx Tx) error {
+		var err error
+		version, err = readSchemaVersion(tx)
+		return err
+	})
+	return version, err
+}
+
+// Migrate brings the store to the version of the last migration, the migrations are numbered from 1
+// without gaps. Every migration runs in its own transaction along with the version it records, a
+// failing migration leaves the store at the version before it. A store with a version newer than
+// the last migration is refused with ErrNewerSchema. Migrate returns the version the store was at
+// and the version it reached.
+func (d Store) Migrate(migrations []Migration) (from, to int, err error) {
+	if err := checkSequence(migrations); err != nil {
+		return 0, 0, err
+	}
+	from, err = d.SchemaVersion()
+	if err != nil {
+		return 0, 0, err
+	}
+	latest := len(migrations)
+	if from > latest {
+		return from, from, fmt.Errorf("%w: schema version %d, this agent supports up to %d", ErrNewerSchema, from, latest)
+	}
+	to = from
+	for _, m := range migrations[from:] {
+		err := d.backend().Update(func(tx Tx) error {
+			if err := m.Migrate(tx); err != nil {
+				return err
+			}
+			return tx.Put(DBMetadataTableName, schemaVersionKey, []byte(strconv.Itoa(m.Version)))
+		})
+		if err != nil {
+			return from, to, fmt.Errorf("migrate state store to schema version %d (%s): %w", m.Version, m.Description, err)
+		}
+		to = m.Version
+	}
+	return from, to, nil
+}
+
+// MigrateTx brings the state of the transaction to the version of the last migration as Migrate does,
+// every migration runs in that transaction: a failing migration leaves nothing of the others once the
+// transaction is rolled back.
+func MigrateTx(tx Tx, migrations []Migration) (from, to int, err error) {
+	if err := checkSequence(migrations); err != nil {
+		return 0, 0, err
+	}
+	from, err = readSchemaVersion(tx)
+	if err != nil {
+		return 0, 0, err
+	}
+	latest := len(migrations)
+	if from > latest {
+		return from, from, fmt.Errorf("%w: schema version %d, this agent supports up to %d", ErrNewerSchema, from, latest)
+	}
+	to = from
+	for _, m := range migrations[from:] {
+		if err := m.Migrate(tx); err != nil {
+			return from, to, fmt.Errorf("migrate state store to schema version %d (%s): %w", m.Version, m.Description, err)
+		}
+		if err := tx.Put(DBMetadataTableName, schemaVersionKey, []byte(strconv.Itoa(m.Version))); err != nil {
+			return from, to, err
+		}
+		to = m.Version
+	}
+	return from, to, nil
+}
+
+// checkSequence checks that the migrations are numbered from 1 without gaps.
+func checkSequence(migrations []Migration) error {
+	for i, m := range migrations {
+		if m.Version != i+1 || m.Migrate == nil {
+			return fmt.Errorf("migration %d (%s) is out of sequence", m.Version, m.Description)
+		}
+	}
+	return nil
+}
+
+func readSchemaVersion(tx Tx) (int, error) {
+	v := tx.Get(DBMetadataTableName, schemaVersionKey)
+	if v == nil {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(string(v))
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("invalid schema version %q", string(v))
+	}
+	return version, nil
+}
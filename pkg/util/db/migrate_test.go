@@ -0,0 +1,102 @@
+package db
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// openTestStore returns a table of a bbolt database of its own.
+func openTestStore(t *testing.T, tableName string) *Store {
+	t.Helper()
+	backend, err := NewBoltBackend(filepath.Join(t.TempDir(), DBName))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, backend.Close())
+	})
+	return &Store{TableName: tableName, Backend: backend}
+}
+
+func TestStore_Migrate(t *testing.T) {
+	var applied []int
+	migration := func(version int) Migration {
+		return Migration{Version: version, Description: "test", Migrate: func(tx Tx) error {
+			applied = append(applied, version)
+			return tx.Put("data", "key", []byte{byte(version)})
+		}}
+	}
+	store := openTestStore(t, DBMetadataTableName)
+
+	from, to, err := store.Migrate([]Migration{migration(1), migration(2)})
+	require.NoError(t, err)
+	require.Equal(t, 0, from)
+	require.Equal(t, 2, to)
+	require.Equal(t, []int{1, 2}, applied)
+
+	// applied migrations are not run again
+	from, to, err = store.Migrate([]Migration{migration(1), migration(2), migration(3)})
+	require.NoError(t, err)
+	require.Equal(t, 2, from)
+	require.Equal(t, 3, to)
+	require.Equal(t, []int{1, 2, 3}, applied)
+
+	// an older agent refuses the store
+	_, _, err = store.Migrate([]Migration{migration(1)})
+	require.ErrorIs(t, err, ErrNewerSchema)
+
+	// a failing migration leaves the store at the version before it
+	failing := Migration{Version: 4, Description: "failing", Migrate: func(tx Tx) error {
+		if err := tx.Put("data", "key", []byte{4}); err != nil {
+			return err
+		}
+		return errors.New("migration failed")
+	}}
+	_, to, err = store.Migrate([]Migration{migration(1), migration(2), migration(3), failing})
+	require.ErrorContains(t, err, "migration failed")
+	require.Equal(t, 3, to)
+	version, err := store.SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, 3, version)
+	value, err := (&Store{TableName: "data", Backend: store.Backend}).GetBytes("key")
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, value)
+
+	_, _, err = store.Migrate([]Migration{migration(2)})
+	require.ErrorContains(t, err, "out of sequence")
+}
+
+func TestMigrateTx(t *testing.T) {
+	migration := func(version int) Migration {
+		return Migration{Version: version, Description: "test", Migrate: func(tx Tx) error {
+			return tx.Put("data", "key", []byte{byte(version)})
+		}}
+	}
+	failing := Migration{Version: 3, Description: "failing", Migrate: func(tx Tx) error {
+		return errors.New("migration failed")
+	}}
+	store := openTestStore(t, DBMetadataTableName)
+
+	// a failing migration leaves nothing of the ones before it once the transaction is rolled back
+	err := store.Transaction(func(tx Tx) error {
+		_, to, err := MigrateTx(tx, []Migration{migration(1), migration(2), failing})
+		require.Equal(t, 2, to)
+		return err
+	})
+	require.ErrorContains(t, err, "migrate state store to schema version 3 (failing): migration failed")
+	version, err := store.SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, 0, version)
+
+	err = store.Transaction(func(tx Tx) error {
+		from, to, err := MigrateTx(tx, []Migration{migration(1), migration(2)})
+		require.Equal(t, 0, from)
+		require.Equal(t, 2, to)
+		return err
+	})
+	require.NoError(t, err)
+	version, err = store.SchemaVersion()
+	require.NoError(t, err)
+	require.Equal(t, 2, version)
+}
@@ -3,9 +3,7 @@ package db
 import (
 	"fmt"
 	"os"
-	"sync"
-
-	bolt "go.etcd.io/bbolt"
+	"strconv"
 )
 
 const (
@@ -13,101 +11,177 @@ const (
 	DBClusterTableName             = "cluster"
 	DBName                         = "state.db"
 	DBFileLocation                 = "/opt/agent/kubeclusteragent/store"
+	DBFilePath                     = DBFileLocation + "/" + DBName
 	DBClusterStatusTableName       = "cluster-status"
 	DBCustomisationStatus          = "customisation-status"
 	DBClusterAuditHistoryTableName = "cluster-audit-history"
+	DBOperationCheckpointTableName = "operation-checkpoint"
+	DBOperationTableName           = "operation"
+	DBOperationLockTableName       = "operation-lock"
+	DBIdempotencyKeyTableName      = "idempotency-key"
+	DBMetadataTableName            = "metadata"
+	DBClusterSpecRevisionTableName = "cluster-spec-revision"
 )
 
-var db *bolt.DB
-
+// Store is a table of the state store. It is kept by its Backend, the default backend when nil.
 type Store struct {
 	TableName      string
 	DBName         string
 	FilePermission os.FileMode
+	Backend        Backend
 }
 
-var mu = sync.Mutex{}
+func (d Store) backend() Backend {
+	if d.Backend != nil {
+		return d.Backend
+	}
+	return Default()
+}
 
 func (d Store) Set(key string, value interface{}) error {
-	if db == nil {
-		err := d.Launch()
+	return d.backend().Put(d.TableName, key, []byte(fmt.Sprintf("%v", value)))
+}
+
+func (d Store) Get(key string) interface{} {
+	value, err := d.backend().Get(d.TableName, key)
+	if err != nil || len(value) == 0 {
+		return nil
+	}
+	return string(value)
+}
+
+// GetBytes returns a copy of the value of the key, nil when the key is not set. An empty value, which
+// older agents wrote to clear a key, is not set either.
+func (d Store) GetBytes(key string) ([]byte, error) {
+	value, err := d.backend().Get(d.TableName, key)
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+	return value, nil
+}
+
+// SetBytes stores the value of the key as is.
+func (d Store) SetBytes(key string, value []byte) error {
+	return d.backend().Put(d.TableName, key, value)
+}
+
+// List returns every value of the table, in key order.
+func (d Store) List() ([]string, error) {
+	entries, err := d.backend().List(d.TableName)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, string(entry.Value))
+	}
+	return result, nil
+}
+
+// Entries returns every key of the table and its value, in key order.
+func (d Store) Entries() ([]Entry, error) {
+	return d.backend().List(d.TableName)
+}
+
+// Transaction runs fn in a read-write transaction of the backend of the table, nothing fn changed is
+// kept when it fails.
+func (d Store) Transaction(fn func(tx Tx) error) error {
+	return d.backend().Update(fn)
+}
+
+// View runs fn in a read-only transaction of the backend of the table.
+func (d Store) View(fn func(tx Tx) error) error {
+	return d.backend().View(fn)
+}
+
+// Update atomically replaces the value of the key with the one returned by update, which gets the
+// current value or nil when the key is not set. Returning nil deletes the key, returning an error
+// leaves the key unchanged and is returned by Update.
+func (d Store) Update(key string, update func(current []byte) ([]byte, error)) error {
+	return d.backend().Update(func(tx Tx) error {
+		value, err := update(tx.Get(d.TableName, key))
 		if err != nil {
 			return err
 		}
-	}
-	err := db.Update(func(tx *bolt.Tx) error {
-		b, err2 := tx.CreateBucketIfNotExists([]byte(d.TableName))
-		if err2 != nil {
-			return err2
+		if value == nil {
+			return tx.Delete(d.TableName, key)
 		}
-		err := b.Put([]byte(key), []byte(fmt.Sprintf("%v", value)))
+		return tx.Put(d.TableName, key, value)
+	})
+}
+
+// SequenceKey returns the key of a sequence number, the keys sort in the order of their numbers.
+func SequenceKey(sequence int64) string {
+	return fmt.Sprintf("%020d", sequence)
+}
+
+// Append stores, in a single transaction, the value returned by value under the sequence number
+// following the one of the last key of the table, 1 for an empty table. It returns the sequence
+// number, the keys of the table are expected to be SequenceKey keys.
+func (d Store) Append(value func(sequence int64) ([]byte, error)) (int64, error) {
+	var sequence int64
+	err := d.backend().Update(func(tx Tx) error {
+		var last int64
+		err := tx.ForEach(d.TableName, func(k, v []byte) error {
+			if n, err := strconv.ParseInt(string(k), 10, 64); err == nil && n > last {
+				last = n
+			}
+			return nil
+		})
 		if err != nil {
 			return err
 		}
-		return nil
+		data, err := value(last + 1)
+		if err != nil {
+			return err
+		}
+		sequence = last + 1
+		return tx.Put(d.TableName, SequenceKey(sequence), data)
 	})
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return sequence, nil
 }
 
-func (d Store) Get(key string) interface{} {
-	var result interface{}
-	err := db.View(func(tx *bolt.Tx) error {
-		b := tx.Bucket([]byte(d.TableName))
-		if b == nil {
-			return nil
-		}
-		dbResponse := b.Get([]byte(key))
-		if dbResponse == nil {
+// DeleteIf removes, in a single transaction, every key of the table whose value matches.
+func (d Store) DeleteIf(match func(value []byte) bool) error {
+	return d.backend().Update(func(tx Tx) error {
+		// keys are collected first, the table must not be modified while it is iterated
+		var keys []string
+		err := tx.ForEach(d.TableName, func(k, v []byte) error {
+			if match(v) {
+				keys = append(keys, string(k))
+			}
 			return nil
+		})
+		if err != nil {
+			return err
 		}
-		result = string(dbResponse)
-		if result == "" {
-			return fmt.Errorf("%v", "Unable to get key")
+		for _, k := range keys {
+			if err := tx.Delete(d.TableName, k); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
-	if err != nil {
-		return nil
-	}
-	return result
+}
+
+func (d Store) Delete(key string) error {
+	return d.backend().Delete(d.TableName, key)
 }
 
 func (d Store) DeleteAll() error {
-	err := db.Update(func(tx *bolt.Tx) error {
-		delErr := tx.DeleteBucket([]byte(DBClusterTableName))
-		if delErr != nil {
-			return delErr
-		}
-		delErr = tx.DeleteBucket([]byte(DBClusterStatusTableName))
-		if delErr != nil {
-			return delErr
+	return d.backend().Update(func(tx Tx) error {
+		if err := tx.DeleteTable(DBClusterTableName); err != nil {
+			return err
 		}
-		return nil
+		return tx.DeleteTable(DBClusterStatusTableName)
 	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func (d *Store) Launch() error {
-	mu.Lock()
-	var err error
-	if db == nil {
-		db, err = bolt.Open(DBFileLocation+"/"+d.DBName, d.FilePermission, nil)
-	}
-	if err != nil {
-		return err
-	}
-	mu.Unlock()
-	return nil
 }
 
 func (d *Store) Close() {
-	err := db.Close()
+	err := d.backend().Close()
 	if err != nil {
 		return
 	}
@@ -117,9 +191,5 @@ func (d *Store) Connect(tableName string) *Store {
 	d.FilePermission = FilePermission
 	d.TableName = tableName
 	d.DBName = DBName
-	err := d.Launch()
-	if err != nil {
-		return nil
-	}
 	return d
 }
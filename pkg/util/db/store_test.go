@@ -0,0 +1,36 @@
+package db
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_Append(t *testing.T) {
+	store := openTestStore(t, "entries")
+	for want := int64(1); want <= 11; want++ {
+		got, err := store.Append(func(sequence int64) ([]byte, error) {
+			return []byte(strconv.FormatInt(sequence, 10)), nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	values, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, values, 11)
+	// the keys sort in the order of their numbers, 10 and 11 come after 9
+	require.Equal(t, "11", values[10])
+
+	_, err = store.Append(func(sequence int64) ([]byte, error) {
+		return nil, errors.New("failed")
+	})
+	require.Error(t, err)
+	require.NoError(t, store.Delete(SequenceKey(11)))
+	got, err := store.Append(func(sequence int64) ([]byte, error) {
+		return []byte("next"), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(11), got)
+}
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Get returns the value of the key decoded from JSON, nil when the key is not set. A value that
+// cannot be decoded is an error, it is never mistaken for a missing one.
+func Get[T any](d *Store, key string) (*T, error) {
+	data, err := d.GetBytes(key)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+	value := new(T)
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, fmt.Errorf("decode %s/%s: %w", d.TableName, key, err)
+	}
+	return value, nil
+}
+
+// Put stores the value of the key encoded as JSON.
+func Put[T any](d *Store, key string, value *T) error {
+	if value == nil {
+		return fmt.Errorf("store %s/%s: nil value", d.TableName, key)
+	}
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode %s/%s: %w", d.TableName, key, err)
+	}
+	return d.SetBytes(key, data)
+}
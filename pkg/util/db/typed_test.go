@@ -0,0 +1,35 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPut(t *testing.T) {
+	type record struct {
+		Name string `json:"name"`
+	}
+	store := openTestStore(t, "records")
+
+	missing, err := Get[record](store, "key")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	require.NoError(t, Put(store, "key", &record{Name: "value"}))
+	got, err := Get[record](store, "key")
+	require.NoError(t, err)
+	require.Equal(t, &record{Name: "value"}, got)
+
+	// older agents cleared keys with an empty value
+	require.NoError(t, store.SetBytes("key", []byte{}))
+	cleared, err := Get[record](store, "key")
+	require.NoError(t, err)
+	require.Nil(t, cleared)
+
+	require.NoError(t, store.SetBytes("key", []byte("<nil>")))
+	_, err = Get[record](store, "key")
+	require.Error(t, err)
+
+	require.Error(t, Put[record](store, "key", nil))
+}
@@ -0,0 +1,194 @@
+package error
+
+import (
+	"context"
+	"errors"
+	"kubeclusteragent/pkg/constants"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Error is an error of the agent API. It is returned to the clients as a status with its code and an
+// ErrorInfo detail carrying its reason, the failed task, the related operation and whether the
+// request can be retried as is, a retryable error also gets a RetryInfo detail.
+type Error struct {
+	// Code is the gRPC code of the error
+	Code codes.Code
+	// Reason is the reason code of the error, one of the constants.Reason values
+	Reason string
+	// Message describes the error, the message of the cause follows it
+	Message string
+	// Task is the name of the task that failed
+	Task string
+	// OperationID and Operation are the id and the type of the operation the error relates to
+	OperationID string
+	Operation   string
+	// Retryable tells whether the same request may succeed later
+	Retryable bool
+	// Err is the cause of the error
+	Err error
+}
+
+// New returns an error of the code and reason.
+func New(code codes.Code, reason, message string) *Error {
+	return &Error{Code: code, Reason: reason, Message: message}
+}
+
+// Wrap returns an error of the code and reason caused by err.
+func Wrap(err error, code codes.Code, reason, message string) *Error {
+	return &Error{Code: code, Reason: reason, Message: message, Err: err}
+}
+
+// WithTask sets the task that failed.
+func (e *Error) WithTask(task string) *Error {
+	e.Task = task
+	return e
+}
+
+// WithOperation sets the operation the error relates to.
+func (e *Error) WithOperation(id, operation string) *Error {
+	e.OperationID = id
+	e.Operation = operation
+	return e
+}
+
+// WithRetry marks the request as one to retry later.
+func (e *Error) WithRetry() *Error {
+	e.Retryable = true
+	return e
+}
+
+func (e *Error) Error() string {
+	switch {
+	case e.Err == nil:
+		return e.Message
+	case e.Message == "":
+		return e.Err.Error()
+	}
+	return e.Message + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// GRPCStatus returns the status of the error with its details.
+func (e *Error) GRPCStatus() *status.Status {
+	return withDetails(status.New(e.Code, e.Error()), e.details()...)
+}
+
+// details returns the ErrorInfo detail of the error, followed by a RetryInfo detail when it is retryable.
+func (e *Error) details() []protoadapt.MessageV1 {
+	metadata := map[string]string{"retryable": strconv.FormatBool(e.Retryable)}
+	if e.Task != "" {
+		metadata["task"] = e.Task
+	}
+	if e.OperationID != "" {
+		metadata["operationId"] = e.OperationID
+	}
+	if e.Operation != "" {
+		metadata["operation"] = e.Operation
+	}
+	reason := e.Reason
+	if reason == "" {
+		reason = Reason(e.Code)
+	}
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: constants.ErrorDomain, Metadata: metadata}}
+	if e.Retryable {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(constants.DefaultRetryDelay)})
+	}
+	return details
+}
+
+func withDetails(st *status.Status, details ...protoadapt.MessageV1) *status.Status {
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// Status returns the gRPC status error of err. An error with a status, even wrapped, keeps its code
+// and details, and gets an ErrorInfo detail when it has none. The context errors get their codes,
+// any other error the given code.
+func Status(err error, code codes.Code) error {
+	if err == nil {
+		return nil
+	}
+	var withStatus interface{ GRPCStatus() *status.Status }
+	switch {
+	case errors.As(err, &withStatus):
+		st := withStatus.GRPCStatus()
+		if wrapped, ok := withStatus.(error); !ok || wrapped != err {
+			// the error wrapping the one with the status describes it best
+			p := st.Proto()
+			p.Message = err.Error()
+			st = status.FromProto(p)
+		}
+		return WithErrorInfo(st).Err()
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	}
+	return (&Error{Code: code, Err: err, Retryable: Retryable(code)}).GRPCStatus().Err()
+}
+
+// WithErrorInfo returns the status with an ErrorInfo detail added to its details, the reason of a
+// status without one is named after its code.
+func WithErrorInfo(st *status.Status) *status.Status {
+	if st.Code() == codes.OK || ErrorInfo(st) != nil {
+		return st
+	}
+	return withDetails(st, (&Error{Code: st.Code(), Retryable: Retryable(st.Code())}).details()...)
+}
+
+// ErrorInfo returns the ErrorInfo detail of the status, nil when it has none.
+func ErrorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+// RetryDelay returns the delay of the RetryInfo detail of the status, false when it has none.
+func RetryDelay(st *status.Status) (time.Duration, bool) {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
+
+// Retryable tells whether a request that failed with the code may succeed when retried as is.
+func Retryable(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.Aborted, codes.ResourceExhausted, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// Reason returns the reason of an error of the code without a more specific one, the name of the
+// code like INVALID_ARGUMENT.
+func Reason(code codes.Code) string {
+	var reason strings.Builder
+	for i, r := range code.String() {
+		if i > 0 && unicode.IsUpper(r) {
+			reason.WriteByte('_')
+		}
+		reason.WriteRune(unicode.ToUpper(r))
+	}
+	return reason.String()
+}
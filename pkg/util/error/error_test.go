@@ -0,0 +1,133 @@
+package error
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"kubeclusteragent/pkg/constants"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestError_GRPCStatus(t *testing.T) {
+	err := Wrap(errors.New("kubelet is not running"), codes.Internal, constants.ReasonOperationFailed, "upgrade cluster").
+		WithTask("upgrade-kubelet").WithOperation("op-1", constants.OperationUpgrade)
+	require.Equal(t, "upgrade cluster: kubelet is not running", err.Error())
+
+	st := err.GRPCStatus()
+	require.Equal(t, codes.Internal, st.Code())
+	require.Equal(t, "upgrade cluster: kubelet is not running", st.Message())
+	require.Len(t, st.Details(), 1)
+	info := ErrorInfo(st)
+	require.Equal(t, constants.ReasonOperationFailed, info.GetReason())
+	require.Equal(t, constants.ErrorDomain, info.GetDomain())
+	require.Equal(t, map[string]string{
+		"retryable":   "false",
+		"task":        "upgrade-kubelet",
+		"operationId": "op-1",
+		"operation":   constants.OperationUpgrade,
+	}, info.GetMetadata())
+	_, ok := RetryDelay(st)
+	require.False(t, ok)
+
+	st = New(codes.Unavailable, constants.ReasonStateStoreUnavailable, "store is closed").WithRetry().GRPCStatus()
+	require.Equal(t, "true", ErrorInfo(st).GetMetadata()["retryable"])
+	delay, ok := RetryDelay(st)
+	require.True(t, ok)
+	require.Equal(t, constants.DefaultRetryDelay, delay)
+}
+
+func TestStatus(t *testing.T) {
+	require.NoError(t, Status(nil, codes.Internal))
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantReason string
+		wantMsg    string
+		retryable  bool
+	}{
+		{
+			name:       "error",
+			err:        errors.New("disk full"),
+			wantCode:   codes.Internal,
+			wantReason: "INTERNAL",
+			wantMsg:    "disk full",
+		},
+		{
+			name:       "wrapped typed error",
+			err:        fmt.Errorf("install cluster: %w", New(codes.AlreadyExists, constants.ReasonClusterAlreadyInitialized, "cluster already initialized")),
+			wantCode:   codes.AlreadyExists,
+			wantReason: constants.ReasonClusterAlreadyInitialized,
+			wantMsg:    "install cluster: cluster already initialized",
+		},
+		{
+			name:       "status without ErrorInfo",
+			err:        status.Error(codes.NotFound, "operation op-1 not found"),
+			wantCode:   codes.NotFound,
+			wantReason: "NOT_FOUND",
+			wantMsg:    "operation op-1 not found",
+		},
+		{
+			name:       "retryable status",
+			err:        status.Error(codes.Aborted, "a request with idempotency key \"k\" is in progress"),
+			wantCode:   codes.Aborted,
+			wantReason: "ABORTED",
+			wantMsg:    "a request with idempotency key \"k\" is in progress",
+			retryable:  true,
+		},
+		{
+			name:       "context error",
+			err:        fmt.Errorf("run script: %w", context.DeadlineExceeded),
+			wantCode:   codes.DeadlineExceeded,
+			wantReason: "DEADLINE_EXCEEDED",
+			wantMsg:    "run script: context deadline exceeded",
+			retryable:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(Status(tt.err, codes.Internal))
+			require.True(t, ok)
+			require.Equal(t, tt.wantCode, st.Code())
+			require.Equal(t, tt.wantMsg, st.Message())
+			require.Equal(t, tt.wantReason, ErrorInfo(st).GetReason())
+			_, retry := RetryDelay(st)
+			require.Equal(t, tt.retryable, retry)
+		})
+	}
+
+	// the details of a status are kept
+	badRequest, err := status.New(codes.InvalidArgument, "invalid request").WithDetails(&errdetails.BadRequest{})
+	require.NoError(t, err)
+	st := status.Convert(Status(badRequest.Err(), codes.Internal))
+	require.Len(t, st.Details(), 2)
+	require.Equal(t, "INVALID_ARGUMENT", ErrorInfo(st).GetReason())
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	mux := runtime.NewServeMux()
+	request := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/cluster", nil)
+
+	recorder := httptest.NewRecorder()
+	err := New(codes.Aborted, constants.ReasonOperationInProgress, "Install operation op-1 is in progress").WithRetry()
+	HTTPErrorHandler(context.Background(), mux, &runtime.JSONPb{}, recorder, request, err.GRPCStatus().Err())
+	require.Equal(t, http.StatusConflict, recorder.Code)
+	require.Equal(t, "5", recorder.Header().Get("Retry-After"))
+	require.Contains(t, recorder.Body.String(), constants.ReasonOperationInProgress)
+
+	recorder = httptest.NewRecorder()
+	err = New(codes.FailedPrecondition, constants.ReasonClusterNotInitialized, "cluster is not initialized")
+	HTTPErrorHandler(context.Background(), mux, &runtime.JSONPb{}, recorder, request, err.GRPCStatus().Err())
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Empty(t, recorder.Header().Get("Retry-After"))
+}
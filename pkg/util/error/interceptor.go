@@ -0,0 +1,43 @@
+package error
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor returns every error of the handlers as a status with an ErrorInfo detail, an
+// error without a status is Internal.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, Status(err, codes.Internal)
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor returns every error of the stream handlers as UnaryServerInterceptor does.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return Status(handler(srv, ss), codes.Internal)
+	}
+}
+
+// HTTPErrorHandler writes the errors of the gateway as runtime.DefaultHTTPErrorHandler does, with the
+// HTTP status of their gRPC code, and sets the Retry-After header of the retryable ones.
+func HTTPErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	if st, ok := status.FromError(err); ok {
+		if delay, ok := RetryDelay(st); ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+		}
+	}
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
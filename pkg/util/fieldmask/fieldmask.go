@@ -0,0 +1,211 @@
+// Package fieldmask applies the field masks of update requests to the messages they update, and
+// lists the fields that differ between two messages.
+package fieldmask
+
+import (
+	"bytes"
+	"fmt"
+	"kubeclusteragent/pkg/util/secret"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Change is a field that differs between two messages.
+type Change struct {
+	// Path is the path of the field from the root message, the JSON names of the fields joined by dots
+	Path string
+	// Before and After are the values of the field, empty when it is not set. The values of the
+	// sensitive fields are redacted.
+	Before string
+	After  string
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %q -> %q", c.Path, c.Before, c.After)
+}
+
+// Paths returns the paths of the changes.
+func Paths(changes []Change) []string {
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+	return paths
+}
+
+// Diff returns the fields that differ between before and after, two messages of the same type, in the
+// order of their field numbers. The fields of the messages they hold are compared one by one, a message
+// that is not set is compared as an empty one. Lists and maps are compared as a whole.
+func Diff(before, after proto.Message) []Change {
+	var changes []Change
+	diff(before.ProtoReflect(), after.ProtoReflect(), "", &changes)
+	return changes
+}
+
+func diff(before, after protoreflect.Message, prefix string, changes *[]Change) {
+	fields := after.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !before.Has(fd) && !after.Has(fd) {
+			continue
+		}
+		path := join(prefix, fd.JSONName())
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			diff(before.Get(fd).Message(), after.Get(fd).Message(), path, changes)
+			continue
+		}
+		if before.Has(fd) == after.Has(fd) && equal(fd, before.Get(fd), after.Get(fd)) {
+			continue
+		}
+		*changes = append(*changes, Change{Path: path, Before: format(before, fd), After: format(after, fd)})
+	}
+}
+
+// Apply sets the fields of dst named by the paths of the mask to their value in src, a message of the
+// same type: a field that is not set in src is cleared. A path naming a message field replaces the
+// whole message, lists and maps are replaced as a whole. The fields of a path are named by their
+// field name or JSON name, protojson reads the paths of a mask sent as JSON in snake case, these are
+// accepted too. A path naming no field is an error, dst is left unchanged.
+func Apply(dst, src proto.Message, mask *fieldmaskpb.FieldMask) error {
+	patched := proto.Clone(dst)
+	// the values of src are set in dst as they are, they must not be shared with the caller
+	src = proto.Clone(src)
+	for _, path := range mask.GetPaths() {
+		if err := apply(patched.ProtoReflect(), src.ProtoReflect(), strings.Split(path, ".")); err != nil {
+			return fmt.Errorf("path %q: %w", path, err)
+		}
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, patched)
+	return nil
+}
+
+func apply(dst, src protoreflect.Message, names []string) error {
+	fd := field(dst.Descriptor(), names[0])
+	if fd == nil {
+		return fmt.Errorf("%s has no field %s", dst.Descriptor().Name(), names[0])
+	}
+	if len(names) == 1 {
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+		return nil
+	}
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return fmt.Errorf("%s is not a message, it has no field %s", fd.JSONName(), names[1])
+	}
+	return apply(dst.Mutable(fd).Message(), src.Get(fd).Message(), names[1:])
+}
+
+// field returns the field of the message with the name, nil when there is none.
+func field(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+		return fd
+	}
+	if fd := fields.ByJSONName(name); fd != nil {
+		return fd
+	}
+	normalized := normalize(name)
+	for i := 0; i < fields.Len(); i++ {
+		if normalize(string(fields.Get(i).Name())) == normalized {
+			return fields.Get(i)
+		}
+	}
+	return nil
+}
+
+// normalize returns the name without its case and underscores, cniVersion and cni_version match.
+func normalize(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+// equal tells whether two values of the field, a list, a map or a scalar, are equal.
+func equal(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch {
+	case fd.IsList():
+		la, lb := a.List(), b.List()
+		if la.Len() != lb.Len() {
+			return false
+		}
+		for i := 0; i < la.Len(); i++ {
+			if !equalScalar(la.Get(i), lb.Get(i)) {
+				return false
+			}
+		}
+		return true
+	case fd.IsMap():
+		ma, mb := a.Map(), b.Map()
+		if ma.Len() != mb.Len() {
+			return false
+		}
+		same := true
+		ma.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			same = mb.Has(key) && equalScalar(value, mb.Get(key))
+			return same
+		})
+		return same
+	}
+	return equalScalar(a, b)
+}
+
+func equalScalar(a, b protoreflect.Value) bool {
+	switch x := a.Interface().(type) {
+	case []byte:
+		return bytes.Equal(x, b.Bytes())
+	case protoreflect.Message:
+		return proto.Equal(x.Interface(), b.Message().Interface())
+	}
+	return a.Interface() == b.Interface()
+}
+
+// format returns the value of the field of the message, empty when it is not set and redacted when
+// the field is sensitive.
+func format(m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if !m.Has(fd) {
+		return ""
+	}
+	if options, ok := fd.Options().(*descriptorpb.FieldOptions); ok && options.GetDebugRedact() {
+		return secret.Redacted
+	}
+	v := m.Get(fd)
+	switch {
+	case fd.IsList():
+		values := make([]string, 0, v.List().Len())
+		for i := 0; i < v.List().Len(); i++ {
+			values = append(values, formatScalar(v.List().Get(i)))
+		}
+		return "[" + strings.Join(values, " ") + "]"
+	case fd.IsMap():
+		var values []string
+		v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			values = append(values, key.String()+":"+formatScalar(value))
+			return true
+		})
+		sort.Strings(values)
+		return "map[" + strings.Join(values, " ") + "]"
+	}
+	return formatScalar(v)
+}
+
+func formatScalar(v protoreflect.Value) string {
+	if message, ok := v.Interface().(protoreflect.Message); ok {
+		return "{" + strings.TrimSpace(prototext.MarshalOptions{}.Format(message.Interface())) + "}"
+	}
+	return v.String()
+}
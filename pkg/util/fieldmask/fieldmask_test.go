@@ -0,0 +1,83 @@
+package fieldmask
+
+import (
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/util/secret"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func clusterSpec() *v1alpha1.ClusterSpec {
+	disableWorkloads := false
+	return &v1alpha1.ClusterSpec{
+		ClusterType: "kubeadm",
+		Version:     "v1.29.3",
+		Networking: &v1alpha1.ClusterNetworking{
+			PodSubnet:  "10.244.0.0/16",
+			SvcSubnet:  "10.96.0.0/12",
+			CniName:    "calico",
+			CniVersion: "v3.27.0",
+		},
+		ApiServer:        &v1alpha1.ClusterAPIServer{CertSANs: []string{"10.0.0.10"}},
+		DisableWorkloads: &disableWorkloads,
+	}
+}
+
+func TestApply(t *testing.T) {
+	disableWorkloads := true
+	src := &v1alpha1.ClusterSpec{
+		Networking:       &v1alpha1.ClusterNetworking{CniVersion: "v3.28.0", PodSubnet: "192.168.0.0/16"},
+		DisableWorkloads: &disableWorkloads,
+	}
+	dst := clusterSpec()
+	// protojson reads networking.cniVersion sent as JSON as networking.cni_version
+	require.NoError(t, Apply(dst, src, &fieldmaskpb.FieldMask{Paths: []string{"networking.cni_version", "disableWorkloads", "apiServer"}}))
+	want := clusterSpec()
+	want.Networking.CniVersion = "v3.28.0"
+	want.DisableWorkloads = &disableWorkloads
+	want.ApiServer = nil
+	require.True(t, proto.Equal(want, dst), "%v", dst)
+
+	// the values set are copies of the ones of src
+	src.Networking.CniVersion = "v3.29.0"
+	require.Equal(t, "v3.28.0", dst.Networking.CniVersion)
+
+	for _, path := range []string{"networking.cniRelease", "version.major"} {
+		err := Apply(dst, src, &fieldmaskpb.FieldMask{Paths: []string{"disableWorkloads", path}})
+		require.Error(t, err, path)
+		require.True(t, proto.Equal(want, dst), "%s changed the message", path)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	require.Empty(t, Diff(clusterSpec(), clusterSpec()))
+
+	before := clusterSpec()
+	after := clusterSpec()
+	after.Networking.CniVersion = "v3.28.0"
+	after.ApiServer.CertSANs = append(after.ApiServer.CertSANs, "edge.example.com")
+	after.DisableWorkloads = nil
+	after.ExtraArgs = map[string]string{"b": "2", "a": "1"}
+	after.ClusterRuntime = &v1alpha1.ClusterRuntime{ClusterCri: &v1alpha1.ContainerRuntimeInterface{
+		RegistryAuth: &v1alpha1.RegistryAuth{Username: "robot", Password: "s3cr3t-password"},
+	}}
+	require.Equal(t, []Change{
+		{Path: "networking.cniVersion", Before: "v3.27.0", After: "v3.28.0"},
+		{Path: "apiServer.certSANs", Before: "[10.0.0.10]", After: "[10.0.0.10 edge.example.com]"},
+		{Path: "disableWorkloads", Before: "false", After: ""},
+		{Path: "extraArgs", Before: "", After: "map[a:1 b:2]"},
+		{Path: "clusterRuntime.clusterCri.registryAuth.username", Before: "", After: "robot"},
+		{Path: "clusterRuntime.clusterCri.registryAuth.password", Before: "", After: secret.Redacted},
+	}, Diff(before, after))
+	require.Equal(t, []string{"networking.cniVersion"}, Paths(Diff(before, &v1alpha1.ClusterSpec{
+		ClusterType:      before.ClusterType,
+		Version:          before.Version,
+		Networking:       after.Networking,
+		ApiServer:        before.ApiServer,
+		DisableWorkloads: before.DisableWorkloads,
+	})))
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"strconv"
+	"time"
 )
 
 // EnvStringVar sets a string flag from environment or defaults to a command line flag.
@@ -30,3 +31,29 @@ func EnvBoolVar(s *bool, envKey, name string, value bool, usage string) {
 
 	flag.BoolVar(s, name, tf, usage)
 }
+
+// EnvIntVar sets an int flag from environment or defaults to a command line flag.
+func EnvIntVar(i *int, envKey, name string, value int, usage string) {
+	v, ok := os.LookupEnv(envKey)
+	if ok {
+		parsed, err := strconv.Atoi(v)
+		if err == nil {
+			value = parsed
+		}
+	}
+
+	flag.IntVar(i, name, value, usage)
+}
+
+// EnvDurationVar sets a duration flag from environment or defaults to a command line flag.
+func EnvDurationVar(d *time.Duration, envKey, name string, value time.Duration, usage string) {
+	v, ok := os.LookupEnv(envKey)
+	if ok {
+		parsed, err := time.ParseDuration(v)
+		if err == nil {
+			value = parsed
+		}
+	}
+
+	flag.DurationVar(d, name, value, usage)
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"net/textproto"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ETagMetadataKey is set on the response header of the methods returning the cluster, to its
+	// resource version.
+	ETagMetadataKey = "etag"
+	// IfMatchMetadataKey carries the If-Match header of a gateway request, the resource version the
+	// request was made against.
+	IfMatchMetadataKey = "if-match"
+)
+
+// ETag returns the entity tag of a resource version, the version in quotes.
+func ETag(resourceVersion int64) string {
+	return strconv.Quote(strconv.FormatInt(resourceVersion, 10))
+}
+
+// SetETag sets the entity tag of the resource version on the response header, the gateway returns it
+// as the ETag header. Outside of a gRPC call it does nothing.
+func SetETag(ctx context.Context, resourceVersion int64) error {
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return nil
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(ETagMetadataKey, ETag(resourceVersion)))
+}
+
+// IfMatch returns the resource version of the If-Match header of a gateway request, 0 when the request
+// has none or matches any version with *. A header that is not the entity tag of a resource version
+// is InvalidArgument.
+func IfMatch(ctx context.Context) (int64, error) {
+	values := metadata.ValueFromIncomingContext(ctx, IfMatchMetadataKey)
+	if len(values) == 0 {
+		return 0, nil
+	}
+	value := strings.TrimSpace(values[0])
+	if value == "*" {
+		return 0, nil
+	}
+	// the resource version is compared as is, a weak entity tag matches the same way
+	tag := strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+	resourceVersion, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || resourceVersion < 1 {
+		return 0, status.Errorf(codes.InvalidArgument, "If-Match %q is not the ETag of a resource version of the cluster", value)
+	}
+	return resourceVersion, nil
+}
+
+// IfMatchHeaderMatcher forwards the If-Match header of the gateway requests to the gRPC metadata,
+// other headers are matched by next.
+func IfMatchHeaderMatcher(next runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(IfMatchMetadataKey) {
+			return IfMatchMetadataKey, true
+		}
+		return next(key)
+	}
+}
+
+// ETagHeaderMatcher returns the etag header of a gRPC response as the ETag header of the gateway
+// response, other headers are matched by next.
+func ETagHeaderMatcher(next runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		if key == ETagMetadataKey {
+			return "ETag", true
+		}
+		return next(key)
+	}
+}
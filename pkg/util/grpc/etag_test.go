@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestIfMatch(t *testing.T) {
+	key, ok := IfMatchHeaderMatcher(runtime.DefaultHeaderMatcher)("If-Match")
+	require.True(t, ok)
+	require.Equal(t, IfMatchMetadataKey, key)
+	key, ok = ETagHeaderMatcher(runtime.DefaultHeaderMatcher)(ETagMetadataKey)
+	require.True(t, ok)
+	require.Equal(t, "ETag", key)
+	require.Equal(t, `"7"`, ETag(7))
+
+	ifMatch := func(value string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IfMatchMetadataKey, value))
+	}
+	for value, want := range map[string]int64{`"7"`: 7, `W/"7"`: 7, "7": 7, "*": 0} {
+		resourceVersion, err := IfMatch(ifMatch(value))
+		require.NoError(t, err, value)
+		require.Equal(t, want, resourceVersion, value)
+	}
+	resourceVersion, err := IfMatch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), resourceVersion)
+	_, err = IfMatch(ifMatch(`"abc"`))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// outside of a gRPC call there is no response header to set
+	require.NoError(t, SetETag(context.Background(), 7))
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/textproto"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// EventStreamContentType is the media type of Server-Sent Events, the one EventSource clients accept.
+	EventStreamContentType = "text/event-stream"
+	// NDJSONContentType is the media type of newline delimited JSON.
+	NDJSONContentType = "application/x-ndjson"
+	// LastEventIDMetadataKey carries the Last-Event-ID header of a client reconnecting to an event stream.
+	LastEventIDMetadataKey = "last-event-id"
+)
+
+// EventStreamMarshaler writes the messages of the gateway responses as Server-Sent Events, one event
+// per message of a server streaming method. A message with a revision gets it as event id, which a
+// reconnecting client sends back in the Last-Event-ID header.
+type EventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+var _ runtime.Delimited = &EventStreamMarshaler{}
+
+func (m *EventStreamMarshaler) ContentType(_ interface{}) string {
+	return EventStreamContentType
+}
+
+func (m *EventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if id, ok := eventID(v); ok {
+		fmt.Fprintf(&buf, "id: %d\n", id)
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// Delimiter ends an event with an empty line.
+func (m *EventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n")
+}
+
+// eventID returns the revision of a message of a server streaming method, the gateway marshals these
+// messages wrapped in a result chunk.
+func eventID(v interface{}) (int64, bool) {
+	chunk, ok := v.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	message, ok := chunk["result"].(interface{ GetRevision() int64 })
+	if !ok {
+		return 0, false
+	}
+	return message.GetRevision(), true
+}
+
+// EventStreamHeaderMatcher forwards the Last-Event-ID header of the gateway requests to the gRPC
+// metadata, other headers are matched by next.
+func EventStreamHeaderMatcher(next runtime.HeaderMatcherFunc) runtime.HeaderMatcherFunc {
+	return func(key string) (string, bool) {
+		if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(LastEventIDMetadataKey) {
+			return LastEventIDMetadataKey, true
+		}
+		return next(key)
+	}
+}
+
+// LastEventID returns the id of the last event received by a client reconnecting to an event stream.
+func LastEventID(ctx context.Context) (int64, bool) {
+	values := metadata.ValueFromIncomingContext(ctx, LastEventIDMetadataKey)
+	if len(values) == 0 {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(values[0], 10, 64)
+	return id, err == nil
+}
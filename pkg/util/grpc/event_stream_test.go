@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestEventStreamMarshaler(t *testing.T) {
+	m := &EventStreamMarshaler{}
+	data, err := m.Marshal(map[string]interface{}{"result": &v1alpha1.WatchClusterEvent{Revision: 7, Type: "Sync"}})
+	require.NoError(t, err)
+	event := strings.TrimPrefix(string(data), "id: 7\ndata: ")
+	require.NotEqual(t, string(data), event)
+	require.JSONEq(t, `{"result":{"revision":"7","type":"Sync"}}`, event)
+	require.True(t, strings.HasSuffix(event, "}\n"))
+	require.Equal(t, "\n", string(m.Delimiter()))
+	require.Equal(t, EventStreamContentType, m.ContentType(nil))
+
+	// a message without revision, as an error chunk, is an event without id
+	data, err = m.Marshal(&v1alpha1.Operation{Id: "operation-1"})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), "data: "))
+	require.JSONEq(t, `{"id":"operation-1"}`, strings.TrimPrefix(string(data), "data: "))
+}
+
+func TestLastEventID(t *testing.T) {
+	key, ok := EventStreamHeaderMatcher(runtime.DefaultHeaderMatcher)("Last-Event-ID")
+	require.True(t, ok)
+	require.Equal(t, LastEventIDMetadataKey, key)
+	key, ok = EventStreamHeaderMatcher(runtime.DefaultHeaderMatcher)("Authorization")
+	require.True(t, ok)
+	require.Equal(t, "grpcgateway-Authorization", key)
+
+	id, ok := LastEventID(metadata.NewIncomingContext(context.Background(), metadata.Pairs(LastEventIDMetadataKey, "42")))
+	require.True(t, ok)
+	require.Equal(t, int64(42), id)
+	_, ok = LastEventID(context.Background())
+	require.False(t, ok)
+}
@@ -112,7 +112,9 @@ func (server *Server) StartWithMetricsServer(ctx context.Context, serverCertFile
 		var clusterStatus cluster.Status = &cluster.LiveStatus{}
 		currentClusterStatus := clusterStatus.GetStatus(ctx)
 		logger.Info("currently agent phase", "phase", currentClusterStatus.Phase)
-		if currentClusterStatus.Phase == constants.ClusterPhaseDeleting ||
+		if checkpoint, _ := clusterStatus.GetCheckpoint(ctx); checkpoint != nil {
+			logger.Info("agent is shutting down , on going operation will be resumed on restart", "operation", checkpoint.Operation, "id", checkpoint.OperationID)
+		} else if currentClusterStatus.Phase == constants.ClusterPhaseDeleting ||
 			currentClusterStatus.Phase == constants.ClusterPhaseProvisioning ||
 			currentClusterStatus.Phase == constants.ClusterPhaseUpgrading ||
 			currentClusterStatus.Phase == constants.ClusterPhaseKubeConfigResetting {
@@ -0,0 +1,177 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/util/log/log"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// MetadataKey is the gRPC metadata carrying the idempotency key, the Idempotency-Key header through the gateway.
+	MetadataKey = "idempotency-key"
+	// ReplayedMetadataKey is set on the response header of a replayed response.
+	ReplayedMetadataKey = "idempotent-replayed"
+	// MaxKeyLength is the maximum length of an idempotency key.
+	MaxKeyLength = 255
+	// PendingTimeout is the time a request may hold its key before it is completed. A reservation left
+	// behind by an agent that stopped while handling the request expires after it.
+	PendingTimeout = time.Minute
+)
+
+// UnaryServerInterceptor makes the given methods idempotent for requests carrying an idempotency key.
+// The first request with a key is handled and its response is kept for ttl, a request repeating the
+// key gets that response back without being handled again. Reusing a key for a different request
+// is rejected, so is a request whose key is held by a request still being handled. Failed requests
+// are not kept, they can be retried with the same key.
+func UnaryServerInterceptor(store Store, ttl time.Duration, methods ...string) grpc.UnaryServerInterceptor {
+	idempotent := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		idempotent[method] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := keyFromContext(ctx)
+		if key == "" || !idempotent[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		logger := log.From(ctx).WithName("idempotency").WithValues("key", key, "method", info.FullMethod)
+		if len(key) > MaxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d characters", MaxKeyLength)
+		}
+		requestHash, err := hashRequest(info.FullMethod, req)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		now := time.Now().UTC()
+		if err := store.PurgeExpired(ctx, now); err != nil {
+			logger.Error(err, "unable to purge expired idempotency keys")
+		}
+		record := &Record{
+			Key:         key,
+			Method:      info.FullMethod,
+			RequestHash: requestHash,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(PendingTimeout),
+		}
+		existing, reserved, err := store.Reserve(ctx, record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "reserve idempotency key: %v", err)
+		}
+		if !reserved {
+			return replay(ctx, existing, record)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			if releaseErr := store.Release(ctx, key); releaseErr != nil {
+				logger.Error(releaseErr, "unable to release idempotency key")
+			}
+			return nil, err
+		}
+		if err := complete(record, resp, ttl); err != nil {
+			logger.Error(err, "unable to record the response of the idempotency key")
+		} else if err := store.Complete(ctx, record); err != nil {
+			logger.Error(err, "unable to record the response of the idempotency key")
+		}
+		return resp, nil
+	}
+}
+
+// replay returns the response recorded for the key.
+func replay(ctx context.Context, existing, record *Record) (interface{}, error) {
+	if existing.Method != record.Method || existing.RequestHash != record.RequestHash {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key %q was used for a different request", record.Key)
+	}
+	if existing.Response == nil {
+		return nil, status.Errorf(codes.Aborted, "a request with idempotency key %q is in progress", record.Key)
+	}
+	response := &anypb.Any{}
+	if err := proto.Unmarshal(existing.Response, response); err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal recorded response: %v", err)
+	}
+	resp, err := response.UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal recorded response: %v", err)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(ReplayedMetadataKey, "true")); err != nil {
+		log.From(ctx).Error(err, "unable to set replayed header")
+	}
+	return resp, nil
+}
+
+// complete records the response and the operation it started, if any, and extends the record to ttl.
+func complete(record *Record, resp interface{}, ttl time.Duration) error {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "response %T is not a protobuf message", resp)
+	}
+	response, err := anypb.New(message)
+	if err != nil {
+		return err
+	}
+	record.Response, err = proto.Marshal(response)
+	if err != nil {
+		return err
+	}
+	if withOperation, ok := resp.(interface{ GetOperation() *v1alpha1.Operation }); ok {
+		record.OperationID = withOperation.GetOperation().GetId()
+	}
+	record.ExpiresAt = record.CreatedAt.Add(ttl)
+	return nil
+}
+
+func keyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
+
+// hashRequest identifies the request, deterministic marshalling keeps the hash stable across calls.
+func hashRequest(method string, req interface{}) (string, error) {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	if message, ok := req.(proto.Message); ok {
+		data, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(data)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// IncomingHeaderMatcher forwards the Idempotency-Key header of the gateway requests to the gRPC
+// metadata, other headers are forwarded the default way.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(MetadataKey) {
+		return MetadataKey, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeaderMatcher returns the replayed header of a gRPC response as the Idempotent-Replayed
+// header of the gateway response, other headers are returned the default way.
+func OutgoingHeaderMatcher(key string) (string, bool) {
+	if key == ReplayedMetadataKey {
+		return textproto.CanonicalMIMEHeaderKey(ReplayedMetadataKey), true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
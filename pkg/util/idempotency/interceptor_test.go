@@ -0,0 +1,194 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+}
+
+var _ Store = &fakeStore{}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{records: map[string]*Record{}}
+}
+
+func (s *fakeStore) Reserve(ctx context.Context, record *Record) (*Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.records[record.Key]; ok && !existing.expired(record.CreatedAt) {
+		return existing, false, nil
+	}
+	stored := *record
+	s.records[record.Key] = &stored
+	return record, true, nil
+}
+
+func (s *fakeStore) Complete(ctx context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored := *record
+	s.records[record.Key] = &stored
+	return nil
+}
+
+func (s *fakeStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+func (s *fakeStore) PurgeExpired(ctx context.Context, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, record := range s.records {
+		if record.expired(now) {
+			delete(s.records, key)
+		}
+	}
+	return nil
+}
+
+const (
+	createMethod = "/agent.v1alpha1.AgentAPI/CreateCluster"
+	deleteMethod = "/agent.v1alpha1.AgentAPI/DeleteCluster"
+)
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, key))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: deleteMethod}
+	request := &v1alpha1.DeleteClusterRequest{}
+
+	t.Run("replays the recorded response", func(t *testing.T) {
+		store := newFakeStore()
+		interceptor := UnaryServerInterceptor(store, time.Hour, deleteMethod)
+		calls := 0
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return &v1alpha1.Cluster{Operation: &v1alpha1.Operation{Id: "operation-1"}}, nil
+		}
+
+		first, err := interceptor(withKey("key"), request, info, handler)
+		require.NoError(t, err)
+		second, err := interceptor(withKey("key"), request, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, 1, calls)
+		require.True(t, proto.Equal(first.(proto.Message), second.(proto.Message)))
+		require.Equal(t, "operation-1", store.records["key"].OperationID)
+	})
+
+	t.Run("rejects a key reused for a different request", func(t *testing.T) {
+		store := newFakeStore()
+		interceptor := UnaryServerInterceptor(store, time.Hour, deleteMethod, createMethod)
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &v1alpha1.Cluster{}, nil
+		}
+		createInfo := &grpc.UnaryServerInfo{FullMethod: createMethod}
+
+		_, err := interceptor(withKey("key"), &v1alpha1.CreateClusterRequest{Kind: "Cluster"}, createInfo, handler)
+		require.NoError(t, err)
+		_, err = interceptor(withKey("key"), &v1alpha1.CreateClusterRequest{Kind: "Other"}, createInfo, handler)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = interceptor(withKey("key"), request, info, handler)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("rejects a key held by a request in progress", func(t *testing.T) {
+		store := newFakeStore()
+		interceptor := UnaryServerInterceptor(store, time.Hour, deleteMethod)
+		now := time.Now().UTC()
+		hash, err := hashRequest(deleteMethod, request)
+		require.NoError(t, err)
+		store.records["key"] = &Record{Key: "key", Method: deleteMethod, RequestHash: hash, CreatedAt: now, ExpiresAt: now.Add(PendingTimeout)}
+
+		_, err = interceptor(withKey("key"), request, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+		require.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("releases the key of a failed request", func(t *testing.T) {
+		store := newFakeStore()
+		interceptor := UnaryServerInterceptor(store, time.Hour, deleteMethod)
+		calls := 0
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("failed")
+			}
+			return &v1alpha1.Cluster{}, nil
+		}
+
+		_, err := interceptor(withKey("key"), request, info, handler)
+		require.Error(t, err)
+		require.Empty(t, store.records)
+		_, err = interceptor(withKey("key"), request, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("handles requests again once the key expired", func(t *testing.T) {
+		store := newFakeStore()
+		interceptor := UnaryServerInterceptor(store, 0, deleteMethod)
+		calls := 0
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return &v1alpha1.Cluster{}, nil
+		}
+
+		_, err := interceptor(withKey("key"), request, info, handler)
+		require.NoError(t, err)
+		_, err = interceptor(withKey("key"), request, info, handler)
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("passes through requests without a key or to other methods", func(t *testing.T) {
+		store := newFakeStore()
+		interceptor := UnaryServerInterceptor(store, time.Hour, deleteMethod)
+		calls := 0
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return &v1alpha1.Cluster{}, nil
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := interceptor(context.Background(), request, info, handler)
+			require.NoError(t, err)
+			_, err = interceptor(withKey("key"), request, &grpc.UnaryServerInfo{FullMethod: "/agent.v1alpha1.AgentAPI/GetCluster"}, handler)
+			require.NoError(t, err)
+		}
+		require.Equal(t, 4, calls)
+		require.Empty(t, store.records)
+	})
+}
+
+func TestHeaderMatchers(t *testing.T) {
+	key, ok := IncomingHeaderMatcher("Idempotency-Key")
+	require.True(t, ok)
+	require.Equal(t, MetadataKey, key)
+
+	header, ok := OutgoingHeaderMatcher(ReplayedMetadataKey)
+	require.True(t, ok)
+	require.Equal(t, "Idempotent-Replayed", header)
+}
@@ -0,0 +1,109 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"kubeclusteragent/pkg/util/db"
+	"time"
+)
+
+// Record is the outcome of a request made with an idempotency key. A record without a response is
+// reserved by a request still being handled.
+type Record struct {
+	Key         string `json:"key"`
+	Method      string `json:"method"`
+	RequestHash string `json:"requestHash"`
+	OperationID string `json:"operationId,omitempty"`
+	// Response is the response of the request, a marshalled anypb.Any.
+	Response  []byte    `json:"response,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (r *Record) expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// Store keeps the records of the idempotency keys.
+type Store interface {
+	// Reserve stores the record unless an unexpired record with the same key exists, in which case the
+	// existing record is returned and reserved is false.
+	Reserve(ctx context.Context, record *Record) (existing *Record, reserved bool, err error)
+	// Complete replaces the reservation with the completed record.
+	Complete(ctx context.Context, record *Record) error
+	// Release removes the record of the key.
+	Release(ctx context.Context, key string) error
+	// PurgeExpired removes the records expired at the given time.
+	PurgeExpired(ctx context.Context, now time.Time) error
+}
+
+type liveStore struct {
+	store db.Store
+}
+
+var _ Store = &liveStore{}
+
+// NewLiveStore returns the store of the idempotency keys kept in the agent state store.
+func NewLiveStore() Store {
+	return &liveStore{}
+}
+
+func (s *liveStore) Reserve(ctx context.Context, record *Record) (*Record, bool, error) {
+	stateStore := s.store.Connect(db.DBIdempotencyKeyTableName)
+	if stateStore == nil {
+		return nil, false, fmt.Errorf("error occoured making connection with the data store")
+	}
+	var existing *Record
+	err := stateStore.Update(record.Key, func(current []byte) ([]byte, error) {
+		if current != nil {
+			stored := &Record{}
+			if err := json.Unmarshal(current, stored); err == nil && !stored.expired(record.CreatedAt) {
+				existing = stored
+				return current, nil
+			}
+		}
+		return json.Marshal(record)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if existing != nil {
+		return existing, false, nil
+	}
+	return record, true, nil
+}
+
+func (s *liveStore) Complete(ctx context.Context, record *Record) error {
+	stateStore := s.store.Connect(db.DBIdempotencyKeyTableName)
+	if stateStore == nil {
+		return fmt.Errorf("error occoured making connection with the data store")
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal idempotency record to JSON: %w", err)
+	}
+	return stateStore.Set(record.Key, string(data))
+}
+
+func (s *liveStore) Release(ctx context.Context, key string) error {
+	stateStore := s.store.Connect(db.DBIdempotencyKeyTableName)
+	if stateStore == nil {
+		return fmt.Errorf("error occoured making connection with the data store")
+	}
+	return stateStore.Delete(key)
+}
+
+func (s *liveStore) PurgeExpired(ctx context.Context, now time.Time) error {
+	stateStore := s.store.Connect(db.DBIdempotencyKeyTableName)
+	if stateStore == nil {
+		return fmt.Errorf("error occoured making connection with the data store")
+	}
+	return stateStore.DeleteIf(func(value []byte) bool {
+		record := &Record{}
+		if err := json.Unmarshal(value, record); err != nil {
+			return true
+		}
+		return record.expired(now)
+	})
+}
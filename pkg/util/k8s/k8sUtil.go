@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,7 +16,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 )
 
-type K8sUtil struct{}
+// K8sUtil runs node scheduling commands through Kubectl, or through the live kubectl when it is
+// not set.
+type K8sUtil struct {
+	Kubectl linux.Kubectl
+}
 
 var retryCount int
 var sleep = 100 * time.Second
@@ -30,13 +35,17 @@ func (k8s *K8sUtil) NodeWorkloadScheduler(ctx context.Context, operationName str
 	if err != nil {
 		return fmt.Errorf("hostname :  %w", err)
 	}
-	_, err = kubectlClient.RunWithResponse(ctx, []string{operationName, nodeName}...)
+	kubectl := k8s.Kubectl
+	if kubectl == nil {
+		kubectl = kubectlClient
+	}
+	_, err = kubectl.RunWithResponse(ctx, []string{operationName, nodeName}...)
 	if err != nil {
 		return fmt.Errorf("kubectl run  : %w", err)
 	}
 	// Verifying if node is in Ready state
 	if operationName == "uncordon" {
-		data, err := kubectlClient.RunWithResponse(ctx, []string{"get", "nodes"}...)
+		data, err := kubectl.RunWithResponse(ctx, []string{"get", "nodes"}...)
 		if err != nil {
 			return fmt.Errorf("kubectl run  : %w", err)
 		}
@@ -113,6 +122,55 @@ func CopyConfigMap(clientset *kubernetes.Clientset, ctx context.Context, namespa
 	return cmCopy, nil, false
 }
 
+// CopyConfigMapWithKubectl returns a copy of the config-map that can be created again, along with
+// whether the config-map was not found.
+func CopyConfigMapWithKubectl(ctx context.Context, kubectl linux.Kubectl, namespace, name string) (*v1.ConfigMap, bool, error) {
+	output, err := kubectl.RunWithResponse(ctx, "get", "configmap", name, "-n", namespace, "-o", "json")
+	if strings.Contains(output, "NotFound") {
+		return nil, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	cm := new(v1.ConfigMap)
+	if err := json.Unmarshal([]byte(output), cm); err != nil {
+		return nil, false, fmt.Errorf("decode config-map %s/%s: %w", namespace, name, err)
+	}
+	cm.ObjectMeta.CreationTimestamp = metav1.Time{}
+	cm.ObjectMeta.ResourceVersion = ""
+	cm.ObjectMeta.UID = ""
+	cm.ObjectMeta.ManagedFields = nil
+	return cm, false, nil
+}
+
+// DeleteConfigMapWithKubectl removes the config-map, a missing config-map is not an error.
+func DeleteConfigMapWithKubectl(ctx context.Context, kubectl linux.Kubectl, namespace, name string) error {
+	_, err := kubectl.RunWithResponse(ctx, "delete", "configmap", name, "-n", namespace, "--ignore-not-found")
+	return err
+}
+
+// CreateConfigMapWithKubectl writes the config-map to manifestPath and creates it from there. An
+// existing config-map is reported as an AlreadyExists error.
+func CreateConfigMapWithKubectl(ctx context.Context, kubectl linux.Kubectl, fs linux.Filesystem, manifestPath string, configMap *v1.ConfigMap) error {
+	cm := configMap.DeepCopy()
+	cm.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}
+	manifest, err := json.Marshal(cm)
+	if err != nil {
+		return fmt.Errorf("encode config-map %s/%s: %w", cm.Namespace, cm.Name, err)
+	}
+	if err := fs.WriteFile(ctx, manifestPath, manifest, 0600); err != nil {
+		return fmt.Errorf("write config-map manifest: %w", err)
+	}
+	defer func() {
+		_ = fs.Remove(ctx, manifestPath)
+	}()
+	output, err := kubectl.RunWithResponse(ctx, "create", "-f", manifestPath)
+	if strings.Contains(output, "AlreadyExists") {
+		return errors.NewAlreadyExists(v1.Resource("configmaps"), cm.Name)
+	}
+	return err
+}
+
 func DeleteConfigMap(clientset *kubernetes.Clientset, ctx context.Context, namespace, name string) error {
 	err := clientset.CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 	return err
@@ -0,0 +1,292 @@
+package k8sversion
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"kubeclusteragent/pkg/constants"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/magiconair/properties"
+	"go.uber.org/multierr"
+)
+
+// The sources of the available versions.
+const (
+	// SourcePackages are the package repositories configured on the node
+	SourcePackages = "packages"
+	// SourceRepository is the pkgs.k8s.io repository of a minor version, read whether or not it is configured
+	SourceRepository = "pkgs.k8s.io"
+	// SourceCatalog is the offline catalog shipped with the agent
+	SourceCatalog = "catalog"
+	// SourceImages are the control plane images cached by containerd
+	SourceImages = "images"
+)
+
+// kubeadmPackage is the package the versions of the package repositories are read from, kubeadm,
+// kubelet and kubectl are released together.
+const kubeadmPackage = "kubeadm"
+
+// Available is a Kubernetes version the node can install.
+type Available struct {
+	Version Version
+	// PackageVersion is the version of the kubeadm package of the version like 1.29.3-1.1, empty when
+	// no package repository has it
+	PackageVersion string
+	// Sources are the sources the version was found in
+	Sources []string
+}
+
+// Catalog lists the Kubernetes versions the node can install from the package repositories, the
+// offline catalog and the cached control plane images.
+type Catalog struct {
+	exec linux.Exec
+	// catalogFile is the properties file of the offline catalog
+	catalogFile string
+	// indexURL is the format of the package index URL of a minor version
+	indexURL string
+	client   *http.Client
+}
+
+func NewCatalog(exec linux.Exec) *Catalog {
+	return &Catalog{
+		exec:        exec,
+		catalogFile: constants.K8sControlPlaneImageVersionPropertiesFile,
+		indexURL:    constants.KubernetesPackageIndexURL,
+		client:      &http.Client{Timeout: constants.KubernetesPackageIndexTimeout},
+	}
+}
+
+// List returns the available versions, newest first. The pkgs.k8s.io repositories of the minor
+// versions are read along with the configured ones. A source that cannot be read does not fail the
+// list, its error is returned with the versions of the other sources.
+func (c *Catalog) List(ctx context.Context, minors ...string) ([]Available, error) {
+	found := make(map[string]*Available)
+	add := func(source string, version Version, packageVersion string) {
+		key := version.String()
+		available, ok := found[key]
+		if !ok {
+			available = &Available{Version: version}
+			found[key] = available
+		}
+		if available.PackageVersion == "" {
+			available.PackageVersion = packageVersion
+		}
+		for _, s := range available.Sources {
+			if s == source {
+				return
+			}
+		}
+		available.Sources = append(available.Sources, source)
+	}
+
+	var errs error
+	packages, err := c.packageVersions(ctx)
+	errs = multierr.Append(errs, err)
+	for _, packageVersion := range packages {
+		if version, err := ParsePackageVersion(packageVersion); err == nil {
+			add(SourcePackages, version, packageVersion)
+		}
+	}
+	for _, minor := range minors {
+		packages, err := c.repositoryVersions(ctx, minor)
+		errs = multierr.Append(errs, err)
+		for _, packageVersion := range packages {
+			if version, err := ParsePackageVersion(packageVersion); err == nil {
+				add(SourceRepository, version, packageVersion)
+			}
+		}
+	}
+	catalog, err := c.catalogVersions()
+	errs = multierr.Append(errs, err)
+	for _, version := range catalog {
+		add(SourceCatalog, version, "")
+	}
+	images, err := c.imageVersions(ctx)
+	errs = multierr.Append(errs, err)
+	for _, version := range images {
+		add(SourceImages, version, "")
+	}
+
+	versions := make([]Available, 0, len(found))
+	for _, available := range found {
+		versions = append(versions, *available)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		if c := versions[i].Version.Compare(versions[j].Version); c != 0 {
+			return c > 0
+		}
+		return versions[i].Version.String() < versions[j].Version.String()
+	})
+	return versions, errs
+}
+
+// UpgradeTargets returns the versions a cluster of the current version can be upgraded to in one step.
+func UpgradeTargets(current Version, versions []Available) []Available {
+	var targets []Available
+	for _, available := range versions {
+		if current.UpgradeableTo(available.Version) {
+			targets = append(targets, available)
+		}
+	}
+	return targets
+}
+
+// ParsePackageVersion returns the Kubernetes version of a package version like 1.29.3-1.1 or 1.26.5-00.
+func ParsePackageVersion(packageVersion string) (Version, error) {
+	if i := strings.LastIndex(packageVersion, "-"); i > 0 {
+		packageVersion = packageVersion[:i]
+	}
+	return Parse(packageVersion)
+}
+
+// packageVersions returns the versions of the kubeadm package of the configured repositories, as
+// apt-cache madison lists them.
+func (c *Catalog) packageVersions(ctx context.Context) ([]string, error) {
+	code, output, err := c.exec.Command(ctx, "apt-cache", nil, "madison", kubeadmPackage)
+	if err != nil {
+		return nil, fmt.Errorf("list %s packages: %w", kubeadmPackage, err)
+	}
+	if code != 0 {
+		return nil, fmt.Errorf("list %s packages: unexpected exit code %d: %s", kubeadmPackage, code, strings.TrimSpace(string(output)))
+	}
+	return ParseMadison(output, kubeadmPackage), nil
+}
+
+// ParseMadison returns the versions of the package in the output of apt-cache madison, whose lines
+// are like "kubeadm | 1.29.3-1.1 | https://pkgs.k8s.io/core:/stable:/v1.29/deb  Packages".
+func ParseMadison(output []byte, packageName string) []string {
+	var versions []string
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "|")
+		if len(fields) < 3 || strings.TrimSpace(fields[0]) != packageName {
+			continue
+		}
+		versions = append(versions, strings.TrimSpace(fields[1]))
+	}
+	return versions
+}
+
+// repositoryVersions returns the versions of the kubeadm package of the package index of the
+// pkgs.k8s.io repository of the minor version.
+func (c *Catalog) repositoryVersions(ctx context.Context, minor string) ([]string, error) {
+	url := fmt.Sprintf(c.indexURL, minor)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("read package index of %s: %w", minor, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("read package index of %s: %s returned %s", minor, url, response.Status)
+	}
+	return parsePackageIndex(response.Body, kubeadmPackage)
+}
+
+// parsePackageIndex returns the versions of the package in a Debian package index, made of stanzas
+// of "Field: value" lines.
+func parsePackageIndex(index io.Reader, packageName string) ([]string, error) {
+	var versions []string
+	var current string
+	scanner := bufio.NewScanner(index)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		field, value, ok := strings.Cut(scanner.Text(), ":")
+		switch {
+		case !ok:
+			// a blank line ends the stanza of a package
+			current = ""
+		case field == "Package":
+			current = strings.TrimSpace(value)
+		case field == "Version" && current == packageName:
+			versions = append(versions, strings.TrimSpace(value))
+		}
+	}
+	return versions, scanner.Err()
+}
+
+// catalogVersions returns the versions of the offline catalog, the Kubernetes versions of its
+// K8S_RELEASE_KUBERNETES_VERSION entry separated by commas. A node without catalog has no versions.
+func (c *Catalog) catalogVersions() ([]Version, error) {
+	if _, err := os.Stat(c.catalogFile); os.IsNotExist(err) {
+		return nil, nil
+	}
+	file, err := properties.LoadFile(c.catalogFile, properties.UTF8)
+	if err != nil {
+		return nil, fmt.Errorf("read version catalog: %w", err)
+	}
+	value, ok := file.Get(constants.K8sPropFile)
+	if !ok {
+		return nil, nil
+	}
+	var versions []Version
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+		version, err := Parse(field)
+		if err != nil {
+			return versions, fmt.Errorf("read version catalog %s: %w", c.catalogFile, err)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// imageVersions returns the versions of the kube-apiserver images cached by containerd, the image
+// tags replace the + of the build metadata with _.
+func (c *Catalog) imageVersions(ctx context.Context) ([]Version, error) {
+	code, output, err := c.exec.Command(ctx, "ctr", nil, "-n="+constants.ContainerdKubernetesNamespace, "images", "ls", "-q")
+	if err != nil {
+		return nil, fmt.Errorf("list cached images: %w", err)
+	}
+	if code != 0 {
+		return nil, fmt.Errorf("list cached images: unexpected exit code %d: %s", code, strings.TrimSpace(string(output)))
+	}
+	var versions []Version
+	for _, image := range strings.Fields(string(output)) {
+		// the registry of the image may have a port, the tag follows the last colon
+		i := strings.LastIndex(image, ":")
+		if i < 0 || strings.Contains(image, "@") {
+			continue
+		}
+		name, tag := image[:i], image[i+1:]
+		if !strings.HasSuffix(name, "/kube-apiserver") && name != "kube-apiserver" {
+			continue
+		}
+		if version, err := Parse(strings.ReplaceAll(tag, "_", "+")); err == nil {
+			versions = append(versions, version)
+		}
+	}
+	return versions, nil
+}
+
+// UpgradePath returns the versions an upgrade from the current version to the newer target goes
+// through, one per minor version: the newest release of every minor version in between, then the
+// target. It fails when a minor version in between has no available release.
+func UpgradePath(current, target Version, versions []Available) ([]Version, error) {
+	var path []Version
+	for minor := current.Minor + 1; minor < target.Minor; minor++ {
+		var step *Version
+		for i := range versions {
+			v := versions[i].Version
+			if v.Major != current.Major || v.Minor != minor || v.Pre != "" {
+				continue
+			}
+			if step == nil || v.Compare(*step) > 0 {
+				step = &v
+			}
+		}
+		if step == nil {
+			return nil, fmt.Errorf("no release of v%d.%d is available to upgrade through", current.Major, minor)
+		}
+		path = append(path, *step)
+	}
+	return append(path, target), nil
+}
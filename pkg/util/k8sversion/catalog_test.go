@@ -0,0 +1,129 @@
+package k8sversion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"kubeclusteragent/pkg/constants"
+
+	"github.com/stretchr/testify/require"
+)
+
+// commandExec answers the commands of the tests with the outputs of their names.
+type commandExec map[string]string
+
+func (e commandExec) Command(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
+	output, ok := e[name]
+	if !ok {
+		return 0, nil, fmt.Errorf("%s: command not found", name)
+	}
+	return 0, []byte(output), nil
+}
+
+func (e commandExec) CommandWithNoLogging(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
+	return e.Command(ctx, name, env, args...)
+}
+
+const madison = `   kubeadm | 1.29.3-1.1 | https://pkgs.k8s.io/core:/stable:/v1.29/deb  Packages
+   kubeadm | 1.29.2-1.1 | https://pkgs.k8s.io/core:/stable:/v1.29/deb  Packages
+`
+
+const packageIndex = `Package: kubeadm
+Architecture: amd64
+Version: 1.30.0-1.1
+
+Package: kubelet
+Architecture: amd64
+Version: 1.30.2-1.1
+
+Package: kubeadm
+Architecture: amd64
+Version: 1.30.1-1.1
+`
+
+func TestCatalog_List(t *testing.T) {
+	index := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, "/v1.30/") {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(packageIndex))
+	}))
+	defer index.Close()
+	catalogFile := filepath.Join(t.TempDir(), "k8s_versions.properties")
+	require.NoError(t, os.WriteFile(catalogFile, []byte(constants.K8sPropFile+"=1.28.9, v1.29.3\n"), 0o600))
+
+	catalog := NewCatalog(commandExec{
+		"apt-cache": madison,
+		"ctr":       "registry.k8s.io/kube-apiserver:v1.29.3\nlocalhost:5000/kube-apiserver:v1.28.9_vmware.1\nregistry.k8s.io/etcd:3.5.12-0\n",
+	})
+	catalog.catalogFile = catalogFile
+	catalog.indexURL = index.URL + "/core:/stable:/%s/deb/Packages"
+
+	versions, err := catalog.List(context.Background(), "v1.30")
+	require.NoError(t, err)
+	found := make(map[string][]string)
+	var order []string
+	for _, available := range versions {
+		order = append(order, available.Version.String())
+		found[available.Version.String()] = available.Sources
+	}
+	require.Equal(t, []string{"v1.30.1", "v1.30.0", "v1.29.3", "v1.29.2", "v1.28.9", "v1.28.9+vmware.1"}, order)
+	require.Equal(t, []string{SourcePackages, SourceCatalog, SourceImages}, found["v1.29.3"])
+	require.Equal(t, []string{SourceRepository}, found["v1.30.1"])
+	require.Equal(t, "1.29.3-1.1", versions[2].PackageVersion)
+
+	// the sources that cannot be read are reported along with the versions of the others
+	catalog.exec = commandExec{"apt-cache": madison}
+	versions, err = catalog.List(context.Background(), "v1.31")
+	require.ErrorContains(t, err, "list cached images")
+	require.ErrorContains(t, err, "read package index of v1.31")
+	require.Len(t, versions, 3)
+}
+
+func TestUpgradeTargets(t *testing.T) {
+	var versions []Available
+	for _, v := range []string{"v1.30.1", "v1.29.0", "v1.28.9", "v1.28.2", "v1.27.0"} {
+		versions = append(versions, Available{Version: mustParse(t, v)})
+	}
+	var targets []string
+	for _, available := range UpgradeTargets(mustParse(t, "v1.28.4"), versions) {
+		targets = append(targets, available.Version.String())
+	}
+	require.Equal(t, []string{"v1.29.0", "v1.28.9"}, targets)
+}
+
+func TestParseMadison(t *testing.T) {
+	require.Equal(t, []string{"1.29.3-1.1", "1.29.2-1.1"}, ParseMadison([]byte(madison), "kubeadm"))
+	require.Empty(t, ParseMadison([]byte(madison), "kubelet"))
+
+	v, err := ParsePackageVersion("1.26.5-00")
+	require.NoError(t, err)
+	require.Equal(t, "v1.26.5", v.String())
+}
+
+func TestUpgradePath(t *testing.T) {
+	var versions []Available
+	for _, v := range []string{"v1.30.0", "v1.29.4", "v1.29.1", "v1.29.5-rc.0", "v1.28.9", "v1.28.10", "v1.27.3"} {
+		versions = append(versions, Available{Version: mustParse(t, v)})
+	}
+	current, target := mustParse(t, "v1.27.4"), mustParse(t, "v1.30.0")
+	require.Equal(t, []string{"v1.28", "v1.29"}, current.MinorsBetween(target))
+	path, err := UpgradePath(current, target, versions)
+	require.NoError(t, err)
+	require.Equal(t, []Version{mustParse(t, "v1.28.10"), mustParse(t, "v1.29.4"), target}, path)
+
+	// an upgrade within the skew policy is a single step
+	path, err = UpgradePath(current, mustParse(t, "v1.28.2"), nil)
+	require.NoError(t, err)
+	require.Equal(t, []Version{mustParse(t, "v1.28.2")}, path)
+
+	_, err = UpgradePath(current, mustParse(t, "v1.32.0"), versions)
+	require.EqualError(t, err, "no release of v1.31 is available to upgrade through")
+}
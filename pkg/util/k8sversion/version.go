@@ -0,0 +1,97 @@
+package k8sversion
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// versionPattern matches the Kubernetes versions, with or without the v prefix, like v1.29.3, 1.29.3,
+// v1.30.0-rc.1 or v1.29.3+k3s1.
+var versionPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-([0-9A-Za-z.-]+))?(?:\+([0-9A-Za-z.-]+))?$`)
+
+// Version is a Kubernetes release version.
+type Version struct {
+	Major int
+	Minor int
+	Patch int
+	// Pre is the pre-release of the version like rc.1, empty for a release
+	Pre string
+	// Build is the build metadata of the version like k3s1, it does not take part in comparisons
+	Build string
+}
+
+// Parse parses a Kubernetes version, with or without the v prefix.
+func Parse(version string) (Version, error) {
+	m := versionPattern.FindStringSubmatch(strings.TrimSpace(version))
+	if m == nil {
+		return Version{}, fmt.Errorf("%q is not a Kubernetes version like v1.29.3", version)
+	}
+	// the pattern only matches numbers that fit
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	patch, _ := strconv.Atoi(m[3])
+	return Version{Major: major, Minor: minor, Patch: patch, Pre: m[4], Build: m[5]}, nil
+}
+
+// String returns the version with the v prefix, like v1.29.3.
+func (v Version) String() string {
+	s := fmt.Sprintf("v%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Pre != "" {
+		s += "-" + v.Pre
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// MinorVersion returns the minor version of the version, like v1.29, the pkgs.k8s.io repositories are
+// split by minor version.
+func (v Version) MinorVersion() string {
+	return fmt.Sprintf("v%d.%d", v.Major, v.Minor)
+}
+
+// Compare returns -1, 0 or 1 when the version is older than, the same as or newer than o. A release is
+// newer than its pre-releases.
+func (v Version) Compare(o Version) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+	switch {
+	case v.Pre == o.Pre:
+		return 0
+	case v.Pre == "":
+		return 1
+	case o.Pre == "":
+		return -1
+	case v.Pre < o.Pre:
+		return -1
+	}
+	return 1
+}
+
+// UpgradeableTo tells whether kubeadm upgrades a cluster of the version to the target in one step. The
+// Kubernetes version skew policy only allows a newer patch of the same minor version or a version of
+// the next minor version.
+func (v Version) UpgradeableTo(target Version) bool {
+	return target.Major == v.Major &&
+		(target.Minor == v.Minor || target.Minor == v.Minor+1) &&
+		target.Compare(v) > 0
+}
+
+// MinorsBetween returns the minor versions between the version and the target, like v1.28 and v1.29
+// from v1.27.4 to v1.30.0.
+func (v Version) MinorsBetween(target Version) []string {
+	var minors []string
+	for minor := v.Minor + 1; minor < target.Minor; minor++ {
+		minors = append(minors, Version{Major: v.Major, Minor: minor}.MinorVersion())
+	}
+	return minors
+}
@@ -0,0 +1,54 @@
+package k8sversion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, version string) Version {
+	t.Helper()
+	v, err := Parse(version)
+	require.NoError(t, err)
+	return v
+}
+
+func TestParse(t *testing.T) {
+	v := mustParse(t, "1.29.3")
+	require.Equal(t, Version{Major: 1, Minor: 29, Patch: 3}, v)
+	require.Equal(t, "v1.29.3", v.String())
+	require.Equal(t, "v1.29", v.MinorVersion())
+	require.Equal(t, "v1.30.0-rc.1+k3s1", mustParse(t, "v1.30.0-rc.1+k3s1").String())
+
+	for _, invalid := range []string{"", "latest", "v1.29", "1.29.03", "v1.29.3.1"} {
+		_, err := Parse(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestVersion_Compare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "v1.29.3", b: "v1.29.3", want: 0},
+		{a: "v1.29.3", b: "v1.29.10", want: -1},
+		{a: "v1.30.0", b: "v1.29.10", want: 1},
+		{a: "v1.30.0", b: "v1.30.0-rc.1", want: 1},
+		{a: "v1.30.0-alpha.1", b: "v1.30.0-rc.1", want: -1},
+		{a: "v1.29.3+k3s1", b: "v1.29.3", want: 0},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, mustParse(t, tt.a).Compare(mustParse(t, tt.b)), "%s <=> %s", tt.a, tt.b)
+	}
+}
+
+func TestVersion_UpgradeableTo(t *testing.T) {
+	current := mustParse(t, "v1.28.4")
+	require.True(t, current.UpgradeableTo(mustParse(t, "v1.28.9")))
+	require.True(t, current.UpgradeableTo(mustParse(t, "v1.29.0")))
+	require.False(t, current.UpgradeableTo(mustParse(t, "v1.28.4")))
+	require.False(t, current.UpgradeableTo(mustParse(t, "v1.28.2")))
+	require.False(t, current.UpgradeableTo(mustParse(t, "v1.30.0")))
+	require.False(t, current.UpgradeableTo(mustParse(t, "v2.29.0")))
+}
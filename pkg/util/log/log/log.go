@@ -2,6 +2,7 @@ package log
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"sync"
@@ -103,6 +104,7 @@ func newLogger(timeformat *string, options ...LoggerOption) logr.Logger {
 		}
 		l = logrus.New()
 		config.update(l, timeformat)
+		l.AddHook(redactHook{})
 		file, _ = os.OpenFile(currentLogFilePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o755)
 		l.SetOutput(io.MultiWriter(os.Stderr, file))
 		logrus.RegisterExitHandler(func() {
@@ -114,3 +116,51 @@ func newLogger(timeformat *string, options ...LoggerOption) logr.Logger {
 	})
 	return logrusr.New(l)
 }
+
+var redactor = struct {
+	sync.RWMutex
+	redact func(string) string
+}{}
+
+// SetRedactor makes every log entry pass through redact before it is written, redact replaces the
+// secrets of the text it is given.
+func SetRedactor(redact func(string) string) {
+	redactor.Lock()
+	defer redactor.Unlock()
+	redactor.redact = redact
+}
+
+// redactHook redacts the message and the values of the log entries.
+type redactHook struct{}
+
+func (redactHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (redactHook) Fire(e *logrus.Entry) error {
+	redactor.RLock()
+	redact := redactor.redact
+	redactor.RUnlock()
+	if redact == nil {
+		return nil
+	}
+	e.Message = redact(e.Message)
+	for key, value := range e.Data {
+		var text string
+		switch v := value.(type) {
+		case string:
+			text = v
+		case error:
+			text = v.Error()
+		case fmt.Stringer:
+			text = v.String()
+		default:
+			continue
+		}
+		// a value is only replaced by its text when it held a secret
+		if redacted := redact(text); redacted != text {
+			e.Data[key] = redacted
+		}
+	}
+	return nil
+}
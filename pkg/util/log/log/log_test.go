@@ -2,9 +2,12 @@ package log
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,3 +51,23 @@ func TestSingleton(t *testing.T) {
 	}
 
 }
+
+func TestRedactHook(t *testing.T) {
+	defer SetRedactor(nil)
+	SetRedactor(func(s string) string {
+		return strings.ReplaceAll(s, "registry-password", "[REDACTED]")
+	})
+	entry := &logrus.Entry{
+		Message: "login with registry-password failed",
+		Data: logrus.Fields{
+			"error":  errors.New("denied for registry-password"),
+			"reason": "registry-password",
+			"count":  3,
+		},
+	}
+	require.NoError(t, redactHook{}.Fire(entry))
+	require.Equal(t, "login with [REDACTED] failed", entry.Message)
+	require.Equal(t, "denied for [REDACTED]", entry.Data["error"])
+	require.Equal(t, "[REDACTED]", entry.Data["reason"])
+	require.Equal(t, 3, entry.Data["count"])
+}
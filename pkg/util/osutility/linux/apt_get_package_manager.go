@@ -110,25 +110,35 @@ func (f *LiveAptGetPackageManager) CheckInstalled(ctx context.Context, packageNa
 	return code == 0
 }
 
+// Install installs the packages, given as name or name=version, and holds them. A held package is
+// unheld first, and a package is downgraded when an older version is given, as a rollback does.
 func (f *LiveAptGetPackageManager) Install(ctx context.Context, packageNames ...string) error {
 	logger := log.From(ctx)
 
 	logger.Info("Installing packages", "packageNames", packageNames)
+	names := packageNamesWithoutVersion(packageNames)
 
-	_, _, err := f.exec.Command(ctx, "apt-mark", nil, append([]string{"unhold"}, packageNames...)...)
+	// a package that is not installed yet is not held, apt-mark only warns about it
+	_, _, err := f.exec.Command(ctx, "apt-mark", nil, append([]string{"unhold"}, names...)...)
 	if err != nil {
 		return fmt.Errorf("unholding packages: %w", err)
 	}
 
-	_, _, err = f.exec.Command(ctx, "apt-get", append(os.Environ(), "DEBIAN_FRONTEND=noninteractive"), append([]string{"install", "-y"}, packageNames...)...)
+	code, output, err := f.exec.Command(ctx, "apt-get", append(os.Environ(), "DEBIAN_FRONTEND=noninteractive"), append([]string{"install", "-y", "--allow-downgrades"}, packageNames...)...)
 	if err != nil {
 		return fmt.Errorf("install packages: %w", err)
 	}
+	if code != 0 {
+		return fmt.Errorf("install packages: unexpected exit code %d: %s", code, string(output))
+	}
 
-	_, _, err = f.exec.Command(ctx, "apt-mark", nil, append([]string{"hold"}, packageNames...)...)
+	code, output, err = f.exec.Command(ctx, "apt-mark", nil, append([]string{"hold"}, names...)...)
 	if err != nil {
 		return fmt.Errorf("holding packages: %w", err)
 	}
+	if code != 0 {
+		return fmt.Errorf("holding packages: unexpected exit code %d: %s", code, string(output))
+	}
 
 	return nil
 }
@@ -137,16 +147,20 @@ func (f *LiveAptGetPackageManager) Uninstall(ctx context.Context, packageNames .
 	logger := log.From(ctx)
 
 	logger.Info("Uninstalling packages", "packageNames", packageNames)
+	names := packageNamesWithoutVersion(packageNames)
 
-	_, _, err := f.exec.Command(ctx, "apt-mark", nil, append([]string{"unhold"}, packageNames...)...)
+	_, _, err := f.exec.Command(ctx, "apt-mark", nil, append([]string{"unhold"}, names...)...)
 	if err != nil {
 		return fmt.Errorf("unholding packages: %w", err)
 	}
 
-	_, _, err = f.exec.Command(ctx, "apt-get", append(os.Environ(), "DEBIAN_FRONTEND=noninteractive"), append([]string{"uninstall", "-y"}, packageNames...)...)
+	code, output, err := f.exec.Command(ctx, "apt-get", append(os.Environ(), "DEBIAN_FRONTEND=noninteractive"), append([]string{"remove", "-y"}, names...)...)
 	if err != nil {
 		return fmt.Errorf("uninstall packages: %w", err)
 	}
+	if code != 0 {
+		return fmt.Errorf("uninstall packages: unexpected exit code %d: %s", code, string(output))
+	}
 
 	return nil
 }
@@ -5,8 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"kubeclusteragent/pkg/util/log/log"
-	"os"
 	"path/filepath"
+	"strings"
 )
 
 type FakeDnfPackageManager struct {
@@ -110,24 +110,35 @@ func (f *LiveDnfPackageManager) CheckInstalled(ctx context.Context, packageName
 	return code == 0
 }
 
+// Install installs the packages, given as name or name=version, and locks them at the installed
+// version with the versionlock plugin. A locked package is unlocked first, and a package is
+// downgraded when an older version is given, as a rollback does.
 func (f *LiveDnfPackageManager) Install(ctx context.Context, packageNames ...string) error {
 	logger := log.From(ctx)
 
 	logger.Info("Installing packages", "packageNames", packageNames)
+	names := packageNamesWithoutVersion(packageNames)
 
-	_, _, err := f.exec.Command(ctx, "apt-mark", nil, append([]string{"unhold"}, packageNames...)...)
+	// a package that is not installed yet is not locked, there is no lock to delete then
+	_, _, err := f.exec.Command(ctx, "dnf", nil, append([]string{"versionlock", "delete"}, names...)...)
 	if err != nil {
-		return fmt.Errorf("unholding packages: %w", err)
+		return fmt.Errorf("unlocking packages: %w", err)
 	}
 
-	_, _, err = f.exec.Command(ctx, "apt-get", append(os.Environ(), "DEBIAN_FRONTEND=noninteractive"), append([]string{"install", "-y"}, packageNames...)...)
+	code, output, err := f.exec.Command(ctx, "dnf", nil, append([]string{"install", "-y"}, dnfPackageSpecs(packageNames)...)...)
 	if err != nil {
 		return fmt.Errorf("install packages: %w", err)
 	}
+	if code != 0 {
+		return fmt.Errorf("install packages: unexpected exit code %d: %s", code, string(output))
+	}
 
-	_, _, err = f.exec.Command(ctx, "apt-mark", nil, append([]string{"hold"}, packageNames...)...)
+	code, output, err = f.exec.Command(ctx, "dnf", nil, append([]string{"versionlock", "add"}, names...)...)
 	if err != nil {
-		return fmt.Errorf("holding packages: %w", err)
+		return fmt.Errorf("locking packages: %w", err)
+	}
+	if code != 0 {
+		return fmt.Errorf("locking packages: unexpected exit code %d: %s", code, string(output))
 	}
 
 	return nil
@@ -137,20 +148,33 @@ func (f *LiveDnfPackageManager) Uninstall(ctx context.Context, packageNames ...s
 	logger := log.From(ctx)
 
 	logger.Info("Uninstalling packages", "packageNames", packageNames)
+	names := packageNamesWithoutVersion(packageNames)
 
-	_, _, err := f.exec.Command(ctx, "apt-mark", nil, append([]string{"unhold"}, packageNames...)...)
+	_, _, err := f.exec.Command(ctx, "dnf", nil, append([]string{"versionlock", "delete"}, names...)...)
 	if err != nil {
-		return fmt.Errorf("unholding packages: %w", err)
+		return fmt.Errorf("unlocking packages: %w", err)
 	}
 
-	_, _, err = f.exec.Command(ctx, "apt-get", append(os.Environ(), "DEBIAN_FRONTEND=noninteractive"), append([]string{"uninstall", "-y"}, packageNames...)...)
+	code, output, err := f.exec.Command(ctx, "dnf", nil, append([]string{"remove", "-y"}, names...)...)
 	if err != nil {
 		return fmt.Errorf("uninstall packages: %w", err)
 	}
+	if code != 0 {
+		return fmt.Errorf("uninstall packages: unexpected exit code %d: %s", code, string(output))
+	}
 
 	return nil
 }
 
+// dnfPackageSpecs turns the packages given as name=version into the name-version specs of dnf.
+func dnfPackageSpecs(packageNames []string) []string {
+	specs := make([]string, 0, len(packageNames))
+	for _, packageName := range packageNames {
+		specs = append(specs, strings.Replace(packageName, "=", "-", 1))
+	}
+	return specs
+}
+
 func (f *LiveDnfPackageManager) Update(ctx context.Context) error {
 	logger := log.From(ctx)
 	logger.Info("Updating packages")
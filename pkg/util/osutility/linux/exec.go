@@ -3,6 +3,7 @@ package linux
 import (
 	"context"
 	"errors"
+	"fmt"
 	"kubeclusteragent/pkg/util/log/log"
 	"os/exec"
 	"syscall"
@@ -35,22 +36,7 @@ func (f *FakeExec) CommandWithNoLogging(ctx context.Context, name string, env []
 	logger := log.From(ctx)
 	logger.Info("Running command", "name", name, "arg", args)
 
-	cmd := exec.Command(name, args...)
-	cmd.Env = env
-
-	data, err := cmd.CombinedOutput()
-	if err != nil {
-		var exitError *exec.ExitError
-		if errors.As(err, &exitError) {
-			if status, ok := exitError.Sys().(syscall.WaitStatus); ok {
-				return status.ExitStatus(), data, nil
-			}
-		}
-
-		return -1, data, err
-	}
-
-	return 0, data, nil
+	return runCommand(ctx, name, env, args...)
 }
 
 type LiveExec struct{}
@@ -67,30 +53,29 @@ func (l LiveExec) Command(ctx context.Context, name string, env []string, args .
 	logger := log.From(ctx)
 	logger.Info("Running command", "name", name, "arg", args)
 
-	cmd := exec.Command(name, args...)
-	cmd.Env = env
-
-	data, err := cmd.CombinedOutput()
-	if err != nil {
-		var exitError *exec.ExitError
-		if errors.As(err, &exitError) {
-			if status, ok := exitError.Sys().(syscall.WaitStatus); ok {
-				return status.ExitStatus(), data, nil
-			}
-		}
-
-		return -1, data, err
-	}
-
-	return 0, data, nil
+	return runCommand(ctx, name, env, args...)
 }
 
 func (l LiveExec) CommandWithNoLogging(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
-	cmd := exec.Command(name, args...)
+	return runCommand(ctx, name, env, args...)
+}
+
+// runCommand runs the command in its own process group, cancelling the context kills the command
+// along with every process it spawned. A command killed by a cancelled context returns the
+// cancellation cause rather than its exit status.
+func runCommand(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
 	cmd.Env = env
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
 
 	data, err := cmd.CombinedOutput()
 	if err != nil {
+		if ctx.Err() != nil {
+			return -1, data, fmt.Errorf("command %s killed: %w", name, context.Cause(ctx))
+		}
 		var exitError *exec.ExitError
 		if errors.As(err, &exitError) {
 			if status, ok := exitError.Sys().(syscall.WaitStatus); ok {
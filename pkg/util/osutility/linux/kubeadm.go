@@ -161,7 +161,11 @@ func (l LiveKubeadm) Upgrade(ctx context.Context, version string, ignorePrefligh
 }
 
 func (l LiveKubeadm) Delete(ctx context.Context) (string, error) {
-	return "", nil
+	code, out, err := l.cmd.Command(ctx, "kubeadm", nil, "reset", "-f")
+	if err != nil || code != 0 {
+		return "", multierr.Append(fmt.Errorf("%s", string(out)), err)
+	}
+	return string(out), nil
 }
 
 func evaluateOverallCertsExpiration(expiryInfo string) (int, map[string]int64, error) {
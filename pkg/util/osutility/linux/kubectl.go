@@ -56,6 +56,12 @@ func NewLiveKubectl(cmd Exec) *LiveKubectl {
 	}
 }
 
+func NewK3sLiveKubectl(cmd Exec) *K3sLiveKubectl {
+	return &K3sLiveKubectl{
+		cmd: cmd,
+	}
+}
+
 func (l *LiveKubectl) Run(ctx context.Context, cmdArgs ...string) error {
 	logger := log.From(ctx).WithName("kubectl")
 	cmdArgs = append(cmdArgs, "--kubeconfig", "/etc/kubernetes/admin.conf")
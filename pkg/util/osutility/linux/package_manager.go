@@ -2,6 +2,7 @@ package linux
 
 import (
 	"context"
+	"strings"
 )
 
 type PackageManagerFactory interface {
@@ -13,3 +14,14 @@ type PackageManagerFactory interface {
 	Uninstall(ctx context.Context, packageNames ...string) error
 	RemoveRepository(ctx context.Context, repository, filename string) error
 }
+
+// packageNamesWithoutVersion returns the names of the packages given as name=version, holding and
+// locking packages take bare names.
+func packageNamesWithoutVersion(packageNames []string) []string {
+	names := make([]string, 0, len(packageNames))
+	for _, packageName := range packageNames {
+		name, _, _ := strings.Cut(packageName, "=")
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,277 @@
+package linux
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"kubeclusteragent/pkg/constants"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Kinds of the actions captured by a Recorder.
+const (
+	ActionCommand         = "command"
+	ActionPackage         = "package"
+	ActionSystemd         = "systemd"
+	ActionKubectl         = "kubectl"
+	ActionKubeadm         = "kubeadm"
+	ActionWriteFile       = "write-file"
+	ActionRemoveFile      = "remove-file"
+	ActionCreateDirectory = "create-directory"
+	ActionChangeOwner     = "change-owner"
+	ActionDownload        = "download"
+)
+
+// Action is a change to the host captured by a Recorder instead of being made.
+type Action struct {
+	Kind        string
+	Description string
+}
+
+// Recorder is an OSUtil that records the changes made through it instead of making them. Queries
+// that only inspect the host, such as reading a file or checking whether a package is installed,
+// still run against the host so the recorded actions are the ones the host would see. Commands
+// whose output is inspected by the tasks answer with the output of a successful run.
+type Recorder struct {
+	mu      sync.Mutex
+	actions []Action
+
+	exec       *recordingExec
+	filesystem *recordingFilesystem
+	sysctl     *LiveSysctl
+	systemd    *LiveSystemd
+	kubectl    *LiveKubectl
+	kubeadm    *LiveKubeadm
+}
+
+var _ OSUtil = &Recorder{}
+
+func NewRecorder() *Recorder {
+	r := &Recorder{}
+	r.exec = &recordingExec{recorder: r, live: NewLiveExec()}
+	r.filesystem = &recordingFilesystem{recorder: r, live: NewLiveFilesystem()}
+	r.sysctl = NewLiveSysctl(r.exec, r.filesystem)
+	r.systemd = NewLiveSystemd(r.exec)
+	r.kubectl = NewLiveKubectl(r.exec)
+	r.kubeadm = NewLiveKubeadm(r.exec)
+	return r
+}
+
+// TakeActions returns the actions recorded since the previous call.
+func (r *Recorder) TakeActions() []Action {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	actions := r.actions
+	r.actions = nil
+	return actions
+}
+
+func (r *Recorder) record(kind, format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.actions = append(r.actions, Action{Kind: kind, Description: fmt.Sprintf(format, args...)})
+}
+
+func (r *Recorder) PackageManager() PackageManagerFactory {
+	return NewAptGetLivePackageManager(r.exec, r.filesystem)
+}
+
+func (r *Recorder) Kubectl() Kubectl {
+	return r.kubectl
+}
+
+func (r *Recorder) Systemd() Systemd {
+	return r.systemd
+}
+
+func (r *Recorder) Filesystem() Filesystem {
+	return r.filesystem
+}
+
+func (r *Recorder) Sysctl() Sysctl {
+	return r.sysctl
+}
+
+func (r *Recorder) Exec() Exec {
+	return r.exec
+}
+
+func (r *Recorder) Kubeadm() Kubeadm {
+	return r.kubeadm
+}
+
+type recordingExec struct {
+	recorder *Recorder
+	live     *LiveExec
+}
+
+var _ Exec = &recordingExec{}
+
+func (e *recordingExec) Command(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
+	if isHostQuery(name, args) {
+		return e.live.Command(ctx, name, env, args...)
+	}
+	if isClusterQuery(name, args) {
+		return 0, []byte(recordedOutput(name, args)), nil
+	}
+	e.recorder.record(commandKind(name), "%s", strings.Join(append([]string{name}, args...), " "))
+	return 0, []byte(recordedOutput(name, args)), nil
+}
+
+func (e *recordingExec) CommandWithNoLogging(ctx context.Context, name string, env []string, args ...string) (int, []byte, error) {
+	return e.Command(ctx, name, env, args...)
+}
+
+// isHostQuery reports whether the command only inspects the host, these are run on the host.
+func isHostQuery(name string, args []string) bool {
+	first := func(values ...string) bool {
+		for _, v := range values {
+			if len(args) > 0 && args[0] == v {
+				return true
+			}
+		}
+		return false
+	}
+	switch name {
+	case "apt-cache":
+		return true
+	case "dpkg":
+		return first("-l")
+	case "systemctl":
+		return first("show", "is-active")
+	case "kubeadm":
+		return first("version") || (first("certs") && len(args) > 1 && args[1] == "check-expiration")
+	}
+	return false
+}
+
+// isClusterQuery reports whether the command reads the kubernetes cluster, these are not run since
+// the cluster may not exist yet when an operation is planned.
+func isClusterQuery(name string, args []string) bool {
+	if name == "k3s" && len(args) > 0 && args[0] == "kubectl" {
+		name, args = "kubectl", args[1:]
+	}
+	return name == "kubectl" && len(args) > 0 && args[0] == "get"
+}
+
+// recordedOutput returns the output of a successful run of the command for the commands whose
+// output is checked by the tasks.
+func recordedOutput(name string, args []string) string {
+	if name == "k3s" && len(args) > 0 && args[0] == "kubectl" {
+		name, args = "kubectl", args[1:]
+	}
+	switch name {
+	case "kubeadm":
+		if len(args) > 0 && args[0] == "init" {
+			return constants.KubeadmClusterSuccessfulInstallationMessage
+		}
+		if len(args) > 2 && args[0] == "upgrade" && args[1] == "apply" {
+			return fmt.Sprintf("%s \"%s\"", constants.KubeadmClusterSuccessfulUpgradeBanner, args[2])
+		}
+	case "kubectl":
+		joined := strings.Join(args, " ")
+		switch {
+		case len(args) > 0 && args[0] == "taint":
+			return "node untainted"
+		case strings.Contains(joined, "-o json"):
+			return "{}"
+		case len(args) > 1 && args[0] == "get" && args[1] == "nodes":
+			return "NAME STATUS\nnode Ready"
+		}
+	}
+	return ""
+}
+
+func commandKind(name string) string {
+	switch name {
+	case "apt-get", "apt-mark", "dpkg", "dnf":
+		return ActionPackage
+	case "systemctl":
+		return ActionSystemd
+	case "kubectl":
+		return ActionKubectl
+	case "kubeadm":
+		return ActionKubeadm
+	}
+	return ActionCommand
+}
+
+type recordingFilesystem struct {
+	recorder *Recorder
+	live     *LiveFilesystem
+}
+
+var _ Filesystem = &recordingFilesystem{}
+
+func (f *recordingFilesystem) WriteFile(ctx context.Context, filename string, contents []byte, perm fs.FileMode) error {
+	f.recorder.record(ActionWriteFile, "write %s (%d bytes, mode %#o)", filename, len(contents), perm)
+	return nil
+}
+
+// OpenFileWithPermission records the write and hands out a file that discards what is written to it.
+func (f *recordingFilesystem) OpenFileWithPermission(ctx context.Context, filename string, flag int, perm fs.FileMode) (*os.File, error) {
+	f.recorder.record(ActionWriteFile, "write %s (mode %#o)", filename, perm)
+	return os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+}
+
+func (f *recordingFilesystem) ReadFile(ctx context.Context, filename string) ([]byte, error) {
+	return f.live.ReadFile(ctx, filename)
+}
+
+func (f *recordingFilesystem) MkdirAll(ctx context.Context, filename string, perm fs.FileMode) error {
+	f.recorder.record(ActionCreateDirectory, "create directory %s (mode %#o)", filename, perm)
+	return nil
+}
+
+func (f *recordingFilesystem) Exists(ctx context.Context, filename string) (bool, error) {
+	return f.live.Exists(ctx, filename)
+}
+
+func (f *recordingFilesystem) RemoveAll(ctx context.Context, filename string) error {
+	f.recorder.record(ActionRemoveFile, "remove %s", filename)
+	return nil
+}
+
+func (f *recordingFilesystem) Remove(ctx context.Context, filename string) error {
+	f.recorder.record(ActionRemoveFile, "remove %s", filename)
+	return nil
+}
+
+// Open records the write and hands out a file that discards what is written to it, Open creates
+// the file on the host.
+func (f *recordingFilesystem) Open(ctx context.Context, filename string) (*os.File, error) {
+	f.recorder.record(ActionWriteFile, "write %s", filename)
+	return os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+}
+
+func (f *recordingFilesystem) Chown(ctx context.Context, filename string, uid int, gid int) error {
+	f.recorder.record(ActionChangeOwner, "change owner of %s to %d:%d", filename, uid, gid)
+	return nil
+}
+
+func (f *recordingFilesystem) Copy(ctx context.Context, dst io.Writer, src io.Reader) (int64, error) {
+	return io.Copy(dst, src)
+}
+
+func (f *recordingFilesystem) WriteNewLine(ctx context.Context, filename string, contents []byte) error {
+	f.recorder.record(ActionWriteFile, "append %q to %s", string(contents), filename)
+	return nil
+}
+
+func (f *recordingFilesystem) DeleteLineFromFileByKey(ctx context.Context, filename, key string) error {
+	f.recorder.record(ActionWriteFile, "delete lines containing %q from %s", key, filename)
+	return nil
+}
+
+func (f *recordingFilesystem) ExtractTarFile(ctx context.Context, src, dst string) error {
+	f.recorder.record(ActionWriteFile, "extract %s to %s", src, dst)
+	return nil
+}
+
+func (f *recordingFilesystem) DownloadFileUsingHttp(ctx context.Context, url, filename string, perm fs.FileMode) ([]byte, error) {
+	f.recorder.record(ActionDownload, "download %s to %s", url, filename)
+	return nil, nil
+}
@@ -0,0 +1,120 @@
+package script
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"kubeclusteragent/pkg/util/log/log"
+	"kubeclusteragent/pkg/util/osutility/linux"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	// ErrDisabled is returned when the agent has no script directory.
+	ErrDisabled = errors.New("scripts are disabled, no script directory is configured")
+	// ErrNotAllowed is returned for scripts outside of the script directory or that can be modified by other users.
+	ErrNotAllowed = errors.New("script is not allowed")
+	// ErrNotFound is returned when the script does not exist.
+	ErrNotFound = errors.New("script not found")
+)
+
+// Runner runs the scripts of the allow-listed script directory, no other file can be run through it.
+type Runner struct {
+	dir  string
+	exec linux.Exec
+}
+
+// Result is the outcome of a script run. A script exiting with a non-zero code is reported
+// through ExitCode, not as an error.
+type Result struct {
+	Script   string
+	ExitCode int
+	Output   []byte
+	Duration time.Duration
+}
+
+func NewRunner(dir string, exec linux.Exec) *Runner {
+	return &Runner{
+		dir:  dir,
+		exec: exec,
+	}
+}
+
+// Path resolves the script name in the given directory, relative to the script directory. The
+// resolved script, symbolic links included, must be an executable regular file of the script
+// directory that only its owner can modify.
+func (r *Runner) Path(dir, name string) (string, error) {
+	if r.dir == "" {
+		return "", ErrDisabled
+	}
+	if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+		return "", fmt.Errorf("%w: invalid script name %q", ErrNotAllowed, name)
+	}
+	if dir != "" && !filepath.IsLocal(dir) {
+		return "", fmt.Errorf("%w: script path %q is outside of the script directory", ErrNotAllowed, dir)
+	}
+	root, err := filepath.EvalSymlinks(r.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%w: script directory %s does not exist", ErrNotFound, r.dir)
+		}
+		return "", fmt.Errorf("resolve script directory: %w", err)
+	}
+	path, err := filepath.EvalSymlinks(filepath.Join(root, dir, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, filepath.Join(dir, name))
+		}
+		return "", fmt.Errorf("resolve script %s: %w", name, err)
+	}
+	if rel, err := filepath.Rel(root, path); err != nil || !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%w: %s resolves outside of the script directory", ErrNotAllowed, name)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("stat script %s: %w", name, err)
+	}
+	switch {
+	case !info.Mode().IsRegular():
+		return "", fmt.Errorf("%w: %s is not a regular file", ErrNotAllowed, name)
+	case info.Mode().Perm()&0o111 == 0:
+		return "", fmt.Errorf("%w: %s is not executable", ErrNotAllowed, name)
+	case info.Mode().Perm()&0o022 != 0:
+		return "", fmt.Errorf("%w: %s is writable by other users", ErrNotAllowed, name)
+	}
+	return path, nil
+}
+
+// Run runs the script with the given arguments, the environment of the agent is extended with env.
+// The script is killed once the timeout expires, zero means no timeout.
+func (r *Runner) Run(ctx context.Context, dir, name string, env []string, timeout time.Duration, args ...string) (*Result, error) {
+	logger := log.From(ctx).WithName("script")
+	path, err := r.Path(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	if len(env) > 0 {
+		env = append(os.Environ(), env...)
+	}
+	logger.Info("Running script", "script", path, "args", args, "timeout", timeout.String())
+	start := time.Now()
+	code, output, err := r.exec.Command(ctx, path, env, args...)
+	result := &Result{
+		Script:   path,
+		ExitCode: code,
+		Output:   output,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		return result, fmt.Errorf("run script %s: %w", name, err)
+	}
+	logger.Info("Script completed", "script", path, "exitCode", code, "duration", result.Duration.String())
+	return result, nil
+}
@@ -0,0 +1,79 @@
+package script
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"kubeclusteragent/pkg/util/osutility/linux"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeScript(t *testing.T, path, contents string, perm os.FileMode) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(contents), perm))
+	require.NoError(t, os.Chmod(path, perm))
+}
+
+func TestRunner_Path(t *testing.T) {
+	dir := t.TempDir()
+	outside := t.TempDir()
+	writeScript(t, filepath.Join(dir, "ok.sh"), "#!/bin/sh\n", 0o755)
+	writeScript(t, filepath.Join(dir, "nested", "ok.sh"), "#!/bin/sh\n", 0o755)
+	writeScript(t, filepath.Join(dir, "plain.sh"), "#!/bin/sh\n", 0o644)
+	writeScript(t, filepath.Join(dir, "shared.sh"), "#!/bin/sh\n", 0o777)
+	writeScript(t, filepath.Join(outside, "escape.sh"), "#!/bin/sh\n", 0o755)
+	require.NoError(t, os.Symlink(filepath.Join(outside, "escape.sh"), filepath.Join(dir, "link.sh")))
+
+	tests := []struct {
+		name    string
+		dir     string
+		script  string
+		wantErr error
+	}{
+		{name: "script", script: "ok.sh"},
+		{name: "nested script", dir: "nested", script: "ok.sh"},
+		{name: "path in name", script: "nested/ok.sh", wantErr: ErrNotAllowed},
+		{name: "parent directory", dir: "..", script: "ok.sh", wantErr: ErrNotAllowed},
+		{name: "absolute directory", dir: outside, script: "escape.sh", wantErr: ErrNotAllowed},
+		{name: "symbolic link out of the directory", script: "link.sh", wantErr: ErrNotAllowed},
+		{name: "not executable", script: "plain.sh", wantErr: ErrNotAllowed},
+		{name: "writable by others", script: "shared.sh", wantErr: ErrNotAllowed},
+		{name: "directory", script: "nested", wantErr: ErrNotAllowed},
+		{name: "missing", script: "missing.sh", wantErr: ErrNotFound},
+	}
+	r := NewRunner(dir, linux.NewLiveExec())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := r.Path(tt.dir, tt.script)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.FileExists(t, path)
+		})
+	}
+
+	_, err := NewRunner("", linux.NewLiveExec()).Path("", "ok.sh")
+	require.ErrorIs(t, err, ErrDisabled)
+}
+
+func TestRunner_Run(t *testing.T) {
+	dir := t.TempDir()
+	writeScript(t, filepath.Join(dir, "echo.sh"), "#!/bin/sh\necho \"$GREETING $1 $2\"\nexit 3\n", 0o755)
+	writeScript(t, filepath.Join(dir, "sleep.sh"), "#!/bin/sh\nsleep 10\n", 0o755)
+	r := NewRunner(dir, linux.NewLiveExec())
+
+	result, err := r.Run(context.Background(), "", "echo.sh", []string{"GREETING=hello"}, time.Minute, "a", "b")
+	require.NoError(t, err)
+	require.Equal(t, 3, result.ExitCode)
+	require.Equal(t, "hello a b\n", string(result.Output))
+
+	_, err = r.Run(context.Background(), "", "sleep.sh", nil, 100*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
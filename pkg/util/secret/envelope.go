@@ -0,0 +1,104 @@
+package secret
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// envelopePrefix starts every encrypted value, <prefix><key id>:<wrapped data key>:<ciphertext> with
+// the binary parts base64 encoded.
+const envelopePrefix = "enc:v1:"
+
+// ErrNoKey is returned when an encrypted value is read without an encryption key configured.
+var ErrNoKey = errors.New("value is encrypted and no encryption key is configured")
+
+// Envelope encrypts every value with a data key of its own, the data key is stored along with the
+// value, wrapped by a key of the provider. Rotating the key of the provider only re-wraps data keys.
+type Envelope struct {
+	provider KeyProvider
+}
+
+// NewEnvelope returns the envelope encryption of the keys of the provider.
+func NewEnvelope(provider KeyProvider) *Envelope {
+	return &Envelope{provider: provider}
+}
+
+// IsEncrypted tells whether the value was written by Encrypt.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, envelopePrefix)
+}
+
+// KeyID returns the id of the key the data key of an encrypted value is wrapped with.
+func KeyID(value string) (string, bool) {
+	if !IsEncrypted(value) {
+		return "", false
+	}
+	keyID, _, found := strings.Cut(strings.TrimPrefix(value, envelopePrefix), ":")
+	return keyID, found
+}
+
+// Encrypt returns the encrypted value of the plaintext.
+func (e *Envelope) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(aead, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := e.provider.WrapKey(dataKey)
+	if err != nil {
+		return "", fmt.Errorf("wrap data key: %w", err)
+	}
+	return envelopePrefix + e.provider.PrimaryKeyID() + ":" + base64.StdEncoding.EncodeToString(wrapped) + ":" +
+		base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// Decrypt returns the plaintext of an encrypted value, a value that is not encrypted is returned as is.
+func (e *Envelope) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	if e == nil {
+		return "", ErrNoKey
+	}
+	parts := strings.Split(strings.TrimPrefix(value, envelopePrefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("malformed encrypted value")
+	}
+	wrapped, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("malformed encrypted value: %w", err)
+	}
+	dataKey, err := e.provider.UnwrapKey(parts[0], wrapped)
+	if err != nil {
+		return "", fmt.Errorf("unwrap data key: %w", err)
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(aead, ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("decrypt value: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// Current tells whether the value is encrypted with the primary key of the provider.
+func (e *Envelope) Current(value string) bool {
+	keyID, ok := KeyID(value)
+	return ok && keyID == e.provider.PrimaryKeyID()
+}
@@ -0,0 +1,196 @@
+package secret
+
+import (
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Redacted replaces the value of a sensitive field in responses, audit entries and logs.
+const Redacted = "[REDACTED]"
+
+// minRememberedLength is the length below which a secret is not redacted from free text, redacting
+// every occurrence of a couple of characters would mangle the text without hiding anything.
+const minRememberedLength = 4
+
+// Seal encrypts the sensitive fields of the message that are not encrypted with the primary key yet,
+// including the ones encrypted with an older key. Without an envelope the fields are left as they are.
+func Seal(msg proto.Message, e *Envelope) error {
+	return transform(msg.ProtoReflect(), func(value string) (string, error) {
+		if !IsEncrypted(value) {
+			remember(value)
+		}
+		if e == nil || e.Current(value) {
+			return value, nil
+		}
+		plaintext, err := e.Decrypt(value)
+		if err != nil {
+			return "", err
+		}
+		remember(plaintext)
+		return e.Encrypt(plaintext)
+	})
+}
+
+// Open decrypts the encrypted sensitive fields of the message, it fails with ErrNoKey when a field is
+// encrypted and the envelope is nil.
+func Open(msg proto.Message, e *Envelope) error {
+	return transform(msg.ProtoReflect(), func(value string) (string, error) {
+		plaintext, err := e.Decrypt(value)
+		if err != nil {
+			return "", err
+		}
+		remember(plaintext)
+		return plaintext, nil
+	})
+}
+
+// NeedsSealing tells whether Seal would change a sensitive field of the message: a field is not
+// encrypted, or is encrypted with a key other than the primary one.
+func NeedsSealing(msg proto.Message, e *Envelope) bool {
+	needed := false
+	_ = transform(msg.ProtoReflect(), func(value string) (string, error) {
+		if e != nil && !e.Current(value) {
+			needed = true
+		}
+		return value, nil
+	})
+	return needed
+}
+
+// HasEncrypted tells whether a sensitive field of the message is encrypted.
+func HasEncrypted(msg proto.Message) bool {
+	encrypted := false
+	_ = transform(msg.ProtoReflect(), func(value string) (string, error) {
+		encrypted = encrypted || IsEncrypted(value)
+		return value, nil
+	})
+	return encrypted
+}
+
+// HasSensitive tells whether a sensitive field of the message is set.
+func HasSensitive(msg proto.Message) bool {
+	set := false
+	_ = transform(msg.ProtoReflect(), func(value string) (string, error) {
+		set = true
+		return value, nil
+	})
+	return set
+}
+
+// Redact replaces the value of every set sensitive field of the message with Redacted.
+func Redact(msg proto.Message) {
+	_ = transform(msg.ProtoReflect(), func(value string) (string, error) {
+		if value != Redacted && !IsEncrypted(value) {
+			remember(value)
+		}
+		return Redacted, nil
+	})
+}
+
+// Unredact gives the sensitive fields of the message that are Redacted, as sent back by a client that
+// read them from a response, the value of the same field of current. Both messages are of the same type.
+func Unredact(msg, current proto.Message) {
+	unredact(msg.ProtoReflect(), current.ProtoReflect())
+}
+
+func unredact(m, current protoreflect.Message) {
+	var restored []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case sensitive(fd):
+			if v.String() == Redacted {
+				restored = append(restored, fd)
+			}
+		case fd.IsList() && fd.Message() != nil:
+			list, currentList := v.List(), current.Get(fd).List()
+			for i := 0; i < list.Len() && i < currentList.Len(); i++ {
+				unredact(list.Get(i).Message(), currentList.Get(i).Message())
+			}
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			unredact(v.Message(), current.Get(fd).Message())
+		}
+		return true
+	})
+	for _, fd := range restored {
+		m.Set(fd, current.Get(fd))
+	}
+}
+
+// sensitive tells whether the field holds a secret, the string fields marked debug_redact in the API.
+func sensitive(fd protoreflect.FieldDescriptor) bool {
+	options, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && options.GetDebugRedact() && fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap()
+}
+
+// transform replaces the value of every set sensitive field of the message, and of the messages it
+// holds, with the one returned by fn. It stops at the first error of fn.
+func transform(m protoreflect.Message, fn func(value string) (string, error)) error {
+	type change struct {
+		fd    protoreflect.FieldDescriptor
+		value string
+	}
+	var changes []change
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case sensitive(fd):
+			var value string
+			if value, err = fn(v.String()); err == nil && value != v.String() {
+				changes = append(changes, change{fd: fd, value: value})
+			}
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = transform(list.Get(i).Message(), fn)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, value protoreflect.Value) bool {
+				err = transform(value.Message(), fn)
+				return err == nil
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			err = transform(v.Message(), fn)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	// the fields are only changed once the message is no longer iterated
+	for _, c := range changes {
+		m.Set(c.fd, protoreflect.ValueOfString(c.value))
+	}
+	return nil
+}
+
+// remembered holds the secrets seen in sensitive fields, they are redacted from free text.
+var remembered = struct {
+	sync.RWMutex
+	values map[string]struct{}
+}{values: make(map[string]struct{})}
+
+func remember(value string) {
+	if len(value) < minRememberedLength {
+		return
+	}
+	remembered.Lock()
+	defer remembered.Unlock()
+	remembered.values[value] = struct{}{}
+}
+
+// RedactString replaces every secret the agent has seen in a sensitive field with Redacted, it is
+// meant for the free text of audit entries, error messages and logs.
+func RedactString(s string) string {
+	remembered.RLock()
+	defer remembered.RUnlock()
+	for value := range remembered.values {
+		if strings.Contains(s, value) {
+			s = strings.ReplaceAll(s, value, Redacted)
+		}
+	}
+	return s
+}
@@ -0,0 +1,65 @@
+package secret
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryServerInterceptor redacts the sensitive fields of the responses and the secrets in the error
+// messages. The handler's response is left untouched, a redacted copy is returned.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, redactError(err)
+		}
+		if msg, ok := resp.(proto.Message); ok && HasSensitive(msg) {
+			redacted := proto.Clone(msg)
+			Redact(redacted)
+			return redacted, nil
+		}
+		return resp, nil
+	}
+}
+
+// redactError returns the error with the secrets of its message redacted, the details of a status are kept.
+func redactError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	message := RedactString(st.Message())
+	if message == st.Message() {
+		return err
+	}
+	p := st.Proto()
+	p.Message = message
+	return status.FromProto(p).Err()
+}
+
+// StreamServerInterceptor redacts the sensitive fields of the messages sent on the streams and the
+// secrets in the error messages, as UnaryServerInterceptor does for the responses.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, &redactingStream{ServerStream: ss}); err != nil {
+			return redactError(err)
+		}
+		return nil
+	}
+}
+
+type redactingStream struct {
+	grpc.ServerStream
+}
+
+func (s *redactingStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(proto.Message); ok && HasSensitive(msg) {
+		redacted := proto.Clone(msg)
+		Redact(redacted)
+		return s.ServerStream.SendMsg(redacted)
+	}
+	return s.ServerStream.SendMsg(m)
+}
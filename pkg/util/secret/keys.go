@@ -0,0 +1,114 @@
+package secret
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeyProvider wraps the data keys of the envelopes with its key encryption keys.
+type KeyProvider interface {
+	// PrimaryKeyID is the id of the key new data keys are wrapped with.
+	PrimaryKeyID() string
+	// WrapKey encrypts the data key with the primary key.
+	WrapKey(dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts a data key wrapped with the key of the id.
+	UnwrapKey(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// ErrUnknownKey is returned when a value was encrypted with a key the provider does not hold.
+var ErrUnknownKey = errors.New("unknown encryption key")
+
+// FileKeyProvider holds the keys of a key file. Every line of the file is a key, <id>:<base64 of 32
+// random bytes>, empty lines and lines starting with # are ignored. The first key is the primary
+// key, the other ones are only used to decrypt the values encrypted before a rotation.
+type FileKeyProvider struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+var _ KeyProvider = &FileKeyProvider{}
+
+// NewFileKeyProvider reads the keys of the key file.
+func NewFileKeyProvider(path string) (*FileKeyProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read encryption key file: %w", err)
+	}
+	p := &FileKeyProvider{keys: make(map[string]cipher.AEAD)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, encoded, found := strings.Cut(line, ":")
+		if !found || id == "" || strings.ContainsAny(id, " \t") {
+			return nil, fmt.Errorf("encryption key file %s line %d: expected <id>:<base64 key>", path, n)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("encryption key file %s line %d: key %s must be 32 base64 encoded bytes", path, n, id)
+		}
+		if _, ok := p.keys[id]; ok {
+			return nil, fmt.Errorf("encryption key file %s line %d: key %s is defined twice", path, n, id)
+		}
+		if p.keys[id], err = newGCM(key); err != nil {
+			return nil, err
+		}
+		if p.primary == "" {
+			p.primary = id
+		}
+	}
+	if p.primary == "" {
+		return nil, fmt.Errorf("encryption key file %s holds no key", path)
+	}
+	return p, nil
+}
+
+func (p *FileKeyProvider) PrimaryKeyID() string {
+	return p.primary
+}
+
+func (p *FileKeyProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	return seal(p.keys[p.primary], dataKey)
+}
+
+func (p *FileKeyProvider) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownKey, keyID)
+	}
+	return open(aead, wrapped)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext with a random nonce, which prefixes the result.
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("encrypted value is truncated")
+	}
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+}
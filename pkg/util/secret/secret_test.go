@@ -0,0 +1,148 @@
+package secret
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func writeKeyFile(t *testing.T, ids ...string) string {
+	var lines []string
+	for _, id := range ids {
+		// the key of an id is the same in every key file
+		key := sha256.Sum256([]byte(id))
+		lines = append(lines, id+":"+base64.StdEncoding.EncodeToString(key[:]))
+	}
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte("# agent keys\n"+strings.Join(lines, "\n")+"\n"), 0600))
+	return path
+}
+
+func newTestEnvelope(t *testing.T, ids ...string) *Envelope {
+	provider, err := NewFileKeyProvider(writeKeyFile(t, ids...))
+	require.NoError(t, err)
+	return NewEnvelope(provider)
+}
+
+func specWithPassword(password string) *v1alpha1.ClusterSpec {
+	return &v1alpha1.ClusterSpec{
+		ClusterName: "edge",
+		ClusterRuntime: &v1alpha1.ClusterRuntime{
+			ClusterCri: &v1alpha1.ContainerRuntimeInterface{
+				RegistryAuth: &v1alpha1.RegistryAuth{IsAuthRequired: true, Username: "robot", Password: password},
+			},
+		},
+	}
+}
+
+func TestEnvelope(t *testing.T) {
+	envelope := newTestEnvelope(t, "2024-05")
+	encrypted, err := envelope.Encrypt("registry-password")
+	require.NoError(t, err)
+	require.True(t, IsEncrypted(encrypted))
+	require.NotContains(t, encrypted, "registry-password")
+	require.True(t, envelope.Current(encrypted))
+	keyID, _ := KeyID(encrypted)
+	require.Equal(t, "2024-05", keyID)
+
+	plaintext, err := envelope.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "registry-password", plaintext)
+
+	_, err = newTestEnvelope(t, "other").Decrypt(encrypted)
+	require.ErrorIs(t, err, ErrUnknownKey)
+	var none *Envelope
+	_, err = none.Decrypt(encrypted)
+	require.ErrorIs(t, err, ErrNoKey)
+}
+
+func TestNewFileKeyProvider_Invalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"no key":        "# nothing\n",
+		"short key":     "k1:" + base64.StdEncoding.EncodeToString([]byte("short")),
+		"missing id":    base64.StdEncoding.EncodeToString(make([]byte, 32)),
+		"duplicate ids": "k1:" + base64.StdEncoding.EncodeToString(make([]byte, 32)) + "\nk1:" + base64.StdEncoding.EncodeToString(make([]byte, 32)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "keys")
+			require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+			_, err := NewFileKeyProvider(path)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	old := newTestEnvelope(t, "old")
+	spec := specWithPassword("registry-password")
+	require.True(t, NeedsSealing(spec, old))
+	require.NoError(t, Seal(spec, old))
+	sealed := spec.ClusterRuntime.ClusterCri.RegistryAuth.Password
+	require.True(t, IsEncrypted(sealed))
+	require.Equal(t, "robot", spec.ClusterRuntime.ClusterCri.RegistryAuth.Username)
+	require.False(t, NeedsSealing(spec, old))
+
+	// the rotated key file keeps the old key behind the new primary one
+	rotated := newTestEnvelope(t, "new", "old")
+	require.True(t, NeedsSealing(spec, rotated))
+	require.NoError(t, Seal(spec, rotated))
+	keyID, _ := KeyID(spec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+	require.Equal(t, "new", keyID)
+
+	require.Error(t, Open(proto.Clone(spec), nil))
+	require.NoError(t, Open(spec, rotated))
+	require.Equal(t, "registry-password", spec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+}
+
+func TestRedact(t *testing.T) {
+	spec := specWithPassword("registry-password")
+	require.True(t, HasSensitive(spec))
+	Redact(spec)
+	require.Equal(t, Redacted, spec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+	require.Equal(t, "robot", spec.ClusterRuntime.ClusterCri.RegistryAuth.Username)
+	require.Equal(t, "login failed for robot:"+Redacted, RedactString("login failed for robot:registry-password"))
+
+	current := specWithPassword("registry-password")
+	Unredact(spec, current)
+	require.Equal(t, "registry-password", spec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+	changed := specWithPassword("new-password")
+	Unredact(changed, current)
+	require.Equal(t, "new-password", changed.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+
+	require.False(t, HasSensitive(&v1alpha1.ClusterSpec{ClusterName: "edge"}))
+}
+
+type recordingStream struct {
+	grpc.ServerStream
+	sent []interface{}
+}
+
+func (s *recordingStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	event := &v1alpha1.WatchClusterEvent{Revision: 1, Cluster: &v1alpha1.Cluster{Spec: specWithPassword("stream-password")}}
+	stream := &recordingStream{}
+	err := StreamServerInterceptor()(nil, stream, &grpc.StreamServerInfo{}, func(_ interface{}, ss grpc.ServerStream) error {
+		require.NoError(t, ss.SendMsg(event))
+		return status.Error(codes.Internal, "registry login failed with stream-password")
+	})
+	require.Equal(t, "registry login failed with "+Redacted, status.Convert(err).Message())
+	require.Len(t, stream.sent, 1)
+	require.Equal(t, Redacted, stream.sent[0].(*v1alpha1.WatchClusterEvent).Cluster.Spec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+	// the event handed to the stream is left untouched
+	require.Equal(t, "stream-password", event.Cluster.Spec.ClusterRuntime.ClusterCri.RegistryAuth.Password)
+}
@@ -0,0 +1,271 @@
+package validation
+
+import (
+	"fmt"
+	"kubeclusteragent/pkg/constants"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+)
+
+// LatestVersion is the version of a k3s cluster spec without one, the latest k3s release is installed.
+const LatestVersion = "latest"
+
+// ClusterTypes are the cluster types the agent creates clusters of.
+var ClusterTypes = []string{constants.ClusterTypeKubeadm, constants.ClusterTypeK3s}
+
+var (
+	// versionPattern matches the Kubernetes versions, with or without the v prefix, like v1.29.3,
+	// 1.29.3 or v1.29.3+k3s1.
+	versionPattern = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+	// dnsNamePattern matches the DNS subdomains, lower case.
+	dnsNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// containerInterfaces are the name prefixes of the interfaces made by the container runtimes and the
+// CNIs, their networks belong to a cluster rather than to the node.
+var containerInterfaces = []string{"antrea", "cali", "cilium", "cni", "docker", "flannel", "genev", "kube-", "lxc", "tunl", "veth", "vxlan", "weave"}
+
+// hostNetworks returns the networks of the node the cluster networks must not overlap.
+var hostNetworks = interfaceNetworks
+
+// SetDefaults fills the fields of the spec that are not set with the values a cluster of its type is
+// created with.
+func SetDefaults(spec *v1alpha1.ClusterSpec) {
+	if spec == nil {
+		return
+	}
+	if spec.DisableWorkloads == nil {
+		disableWorkloads := false
+		spec.DisableWorkloads = &disableWorkloads
+	}
+	switch spec.GetClusterType() {
+	case constants.ClusterTypeK3s:
+		if spec.Version == "" {
+			spec.Version = LatestVersion
+		}
+		if spec.Networking == nil {
+			spec.Networking = &v1alpha1.ClusterNetworking{}
+		}
+		setDefaultSubnets(spec.Networking, constants.DefaultK3sPodNetwork, constants.DefaultK3sServiceNetwork)
+	default:
+		// the networking of a kubeadm cluster carries its CNI, it is not defaulted
+		if spec.Networking != nil {
+			setDefaultSubnets(spec.Networking, constants.DefaultPodNetwork, constants.DefaultServiceNetwork)
+		}
+	}
+}
+
+func setDefaultSubnets(networking *v1alpha1.ClusterNetworking, podSubnet, svcSubnet string) {
+	if networking.PodSubnet == "" {
+		networking.PodSubnet = podSubnet
+	}
+	if networking.SvcSubnet == "" {
+		networking.SvcSubnet = svcSubnet
+	}
+}
+
+// ValidateClusterSpec checks the spec of a cluster to create, with its defaults set. It returns an
+// *Error listing every invalid field, the fields are named by their path from the spec.
+func ValidateClusterSpec(spec *v1alpha1.ClusterSpec) error {
+	e := &Error{}
+	if spec == nil {
+		e.add("spec", "must be set")
+		return e
+	}
+	validateClusterType(e, spec)
+	validateVersion(e, spec)
+	validateNetworking(e, spec)
+	validateAPIServer(e, spec.GetApiServer())
+	validateStorage(e, spec.GetStorage())
+	validateRuntime(e, spec.GetClusterRuntime())
+	return e.orNil()
+}
+
+func validateClusterType(e *Error, spec *v1alpha1.ClusterSpec) {
+	switch {
+	case spec.ClusterType == "":
+		e.add("spec.clusterType", fmt.Sprintf("must be set, one of %s", strings.Join(ClusterTypes, ", ")))
+	case !contains(ClusterTypes, spec.ClusterType):
+		e.add("spec.clusterType", fmt.Sprintf("cluster type %q is not supported, one of %s", spec.ClusterType, strings.Join(ClusterTypes, ", ")))
+	}
+}
+
+func validateVersion(e *Error, spec *v1alpha1.ClusterSpec) {
+	switch {
+	case spec.Version == "":
+		e.add("spec.version", "must be set")
+	case spec.Version == LatestVersion && spec.ClusterType == constants.ClusterTypeK3s:
+	case !ValidVersion(spec.Version):
+		e.add("spec.version", fmt.Sprintf("%q is not a Kubernetes version like v1.29.3", spec.Version))
+	}
+}
+
+// ValidVersion tells whether the version is a Kubernetes version, with or without the v prefix.
+func ValidVersion(version string) bool {
+	return versionPattern.MatchString(version)
+}
+
+func validateNetworking(e *Error, spec *v1alpha1.ClusterSpec) {
+	networking := spec.GetNetworking()
+	if networking == nil {
+		if spec.ClusterType != constants.ClusterTypeK3s {
+			e.add("spec.networking", "must be set, it carries the CNI of the cluster")
+		}
+		return
+	}
+	podSubnet := parseSubnet(e, "spec.networking.podSubnet", networking.PodSubnet)
+	svcSubnet := parseSubnet(e, "spec.networking.svcSubnet", networking.SvcSubnet)
+	if podSubnet != nil && svcSubnet != nil && overlap(podSubnet, svcSubnet) {
+		e.add("spec.networking.svcSubnet", fmt.Sprintf("overlaps the pod subnet %s", podSubnet))
+	}
+	if podSubnet != nil || svcSubnet != nil {
+		// the networks of the node cannot be told when its interfaces cannot be read
+		networks, _ := hostNetworks()
+		for _, network := range networks {
+			if podSubnet != nil && overlap(podSubnet, network) {
+				e.add("spec.networking.podSubnet", fmt.Sprintf("overlaps the network %s of the node", network))
+			}
+			if svcSubnet != nil && overlap(svcSubnet, network) {
+				e.add("spec.networking.svcSubnet", fmt.Sprintf("overlaps the network %s of the node", network))
+			}
+		}
+	}
+	validateCNI(e, spec.ClusterType, networking)
+}
+
+// parseSubnet returns the network of a subnet field, nil when it is not set or not valid.
+func parseSubnet(e *Error, field, subnet string) *net.IPNet {
+	if subnet == "" {
+		return nil
+	}
+	_, network, err := net.ParseCIDR(subnet)
+	if err != nil {
+		e.add(field, fmt.Sprintf("%q is not a CIDR like 10.244.0.0/16", subnet))
+		return nil
+	}
+	return network
+}
+
+func overlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func validateCNI(e *Error, clusterType string, networking *v1alpha1.ClusterNetworking) {
+	if manifest := networking.CniManifestURL; manifest != "" && !validManifestLocation(manifest) {
+		e.add("spec.networking.cniManifestURL", fmt.Sprintf("%q is neither an http(s) URL nor an absolute path", manifest))
+	}
+	if clusterType == constants.ClusterTypeK3s {
+		// k3s installs flannel, or the CNI of the manifest with flannel disabled
+		if networking.CniName != "" {
+			e.add("spec.networking.cniName", "not supported by k3s clusters, which install flannel or the CNI of cniManifestURL")
+		}
+		if networking.Cilium != nil {
+			e.add("spec.networking.cilium", "not supported by k3s clusters, which install flannel or the CNI of cniManifestURL")
+		}
+		return
+	}
+	if networking.CniManifestURL == "" && networking.CniName != constants.CniCilium && (networking.CniName == "" || networking.CniVersion == "") {
+		e.add("spec.networking.cniManifestURL", "must be set, or cniName and cniVersion")
+	}
+	switch {
+	case networking.CniName == constants.CniCilium && networking.GetCilium().GetCliVersion() == "":
+		e.add("spec.networking.cilium.cliVersion", "must be set, Cilium is installed with the cilium CLI")
+	case networking.CniName != constants.CniCilium && networking.Cilium != nil:
+		e.add("spec.networking.cilium", fmt.Sprintf("only applies to the %s CNI", constants.CniCilium))
+	}
+}
+
+func validManifestLocation(location string) bool {
+	if path.IsAbs(location) {
+		return true
+	}
+	u, err := url.Parse(location)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func validateAPIServer(e *Error, apiServer *v1alpha1.ClusterAPIServer) {
+	for i, san := range apiServer.GetCertSANs() {
+		if net.ParseIP(san) == nil && (len(san) > 253 || !dnsNamePattern.MatchString(strings.ToLower(san))) {
+			e.add(fmt.Sprintf("spec.apiServer.certSANs[%d]", i), fmt.Sprintf("%q is neither an IP address nor a DNS name", san))
+		}
+	}
+}
+
+func validateStorage(e *Error, storage *v1alpha1.ClusterStorage) {
+	csi := storage.GetClusterCsi()
+	if csi == nil {
+		return
+	}
+	if csi.Name == "" {
+		e.add("spec.storage.clusterCsi.name", "must be set along with the version")
+	}
+	if csi.Version == "" {
+		e.add("spec.storage.clusterCsi.version", "must be set along with the name")
+	}
+}
+
+func validateRuntime(e *Error, runtime *v1alpha1.ClusterRuntime) {
+	if !runtime.GetCustomiseClusterRuntime() {
+		return
+	}
+	cri := runtime.GetClusterCri()
+	if cri.GetPrivateRegistryFQDN() == "" {
+		e.add("spec.clusterRuntime.clusterCri.privateRegistryFQDN", "must be set to customise the cluster runtime")
+	}
+	if auth := cri.GetRegistryAuth(); auth.GetIsAuthRequired() {
+		if auth.GetUsername() == "" {
+			e.add("spec.clusterRuntime.clusterCri.registryAuth.username", "must be set when the registry requires authentication")
+		}
+		if auth.GetPassword() == "" {
+			e.add("spec.clusterRuntime.clusterCri.registryAuth.password", "must be set when the registry requires authentication")
+		}
+	}
+}
+
+// interfaceNetworks returns the networks of the interfaces of the node that are up, but the loopback
+// and the ones made for containers.
+func interfaceNetworks() ([]*net.IPNet, error) {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	var networks []*net.IPNet
+	for _, i := range interfaces {
+		if i.Flags&net.FlagUp == 0 || i.Flags&net.FlagLoopback != 0 || containerInterface(i.Name) {
+			continue
+		}
+		addresses, err := i.Addrs()
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range addresses {
+			if network, ok := address.(*net.IPNet); ok && !network.IP.IsLinkLocalUnicast() {
+				networks = append(networks, &net.IPNet{IP: network.IP.Mask(network.Mask), Mask: network.Mask})
+			}
+		}
+	}
+	return networks, nil
+}
+
+func containerInterface(name string) bool {
+	for _, prefix := range containerInterfaces {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
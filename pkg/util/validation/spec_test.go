@@ -0,0 +1,204 @@
+package validation
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"kubeclusteragent/gen/go/agent/v1alpha1"
+	"kubeclusteragent/pkg/constants"
+	errorutil "kubeclusteragent/pkg/util/error"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+// withHostNetworks makes the node of the tests have the networks.
+func withHostNetworks(t *testing.T, cidrs ...string) {
+	previous := hostNetworks
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		require.NoError(t, err)
+		networks = append(networks, network)
+	}
+	hostNetworks = func() ([]*net.IPNet, error) { return networks, nil }
+	t.Cleanup(func() { hostNetworks = previous })
+}
+
+func kubeadmSpec() *v1alpha1.ClusterSpec {
+	return &v1alpha1.ClusterSpec{
+		ClusterType: constants.ClusterTypeKubeadm,
+		Version:     "v1.29.3",
+		Networking: &v1alpha1.ClusterNetworking{
+			CniName:    constants.CniCalico,
+			CniVersion: "v3.27.0",
+		},
+	}
+}
+
+// violations returns the descriptions of the violations of the error by field.
+func violations(t *testing.T, err error) map[string]string {
+	var invalid *Error
+	require.True(t, errors.As(err, &invalid), "%v is not a validation error", err)
+	fields := make(map[string]string)
+	for _, violation := range invalid.Violations {
+		fields[violation.Field] = violation.Description
+	}
+	return fields
+}
+
+func TestSetDefaults(t *testing.T) {
+	spec := kubeadmSpec()
+	SetDefaults(spec)
+	require.False(t, spec.GetDisableWorkloads())
+	require.Equal(t, constants.DefaultPodNetwork, spec.Networking.PodSubnet)
+	require.Equal(t, constants.DefaultServiceNetwork, spec.Networking.SvcSubnet)
+
+	// the networking of a kubeadm cluster is left to be set
+	spec = &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeKubeadm}
+	SetDefaults(spec)
+	require.Nil(t, spec.Networking)
+
+	spec = &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeK3s}
+	SetDefaults(spec)
+	require.Equal(t, LatestVersion, spec.Version)
+	require.Equal(t, constants.DefaultK3sPodNetwork, spec.Networking.PodSubnet)
+	require.Equal(t, constants.DefaultK3sServiceNetwork, spec.Networking.SvcSubnet)
+
+	SetDefaults(nil)
+}
+
+func TestValidateClusterSpec(t *testing.T) {
+	withHostNetworks(t, "192.168.1.0/24")
+
+	valid := kubeadmSpec()
+	SetDefaults(valid)
+	require.NoError(t, ValidateClusterSpec(valid))
+	k3s := &v1alpha1.ClusterSpec{ClusterType: constants.ClusterTypeK3s}
+	SetDefaults(k3s)
+	require.NoError(t, ValidateClusterSpec(k3s))
+
+	tests := []struct {
+		name   string
+		modify func(spec *v1alpha1.ClusterSpec)
+		want   map[string]string
+	}{
+		{
+			name:   "unsupported cluster type",
+			modify: func(spec *v1alpha1.ClusterSpec) { spec.ClusterType = "k0s" },
+			want:   map[string]string{"spec.clusterType": `cluster type "k0s" is not supported, one of kubeadm, k3s`},
+		},
+		{
+			name:   "invalid version",
+			modify: func(spec *v1alpha1.ClusterSpec) { spec.Version = "1.29" },
+			want:   map[string]string{"spec.version": `"1.29" is not a Kubernetes version like v1.29.3`},
+		},
+		{
+			name:   "latest kubeadm version",
+			modify: func(spec *v1alpha1.ClusterSpec) { spec.Version = LatestVersion },
+			want:   map[string]string{"spec.version": `"latest" is not a Kubernetes version like v1.29.3`},
+		},
+		{
+			name: "overlapping subnets",
+			modify: func(spec *v1alpha1.ClusterSpec) {
+				spec.Networking.PodSubnet = "10.96.0.0/12"
+				spec.Networking.SvcSubnet = "10.100.0.0/16"
+			},
+			want: map[string]string{"spec.networking.svcSubnet": "overlaps the pod subnet 10.96.0.0/12"},
+		},
+		{
+			name: "subnets of the node",
+			modify: func(spec *v1alpha1.ClusterSpec) {
+				spec.Networking.PodSubnet = "192.168.0.0/16"
+				spec.Networking.SvcSubnet = "10.96/12"
+			},
+			want: map[string]string{
+				"spec.networking.podSubnet": "overlaps the network 192.168.1.0/24 of the node",
+				"spec.networking.svcSubnet": `"10.96/12" is not a CIDR like 10.244.0.0/16`,
+			},
+		},
+		{
+			name: "CNI without version",
+			modify: func(spec *v1alpha1.ClusterSpec) {
+				spec.Networking.CniVersion = ""
+				spec.Networking.Cilium = &v1alpha1.Cilium{CliVersion: "v0.15.0"}
+			},
+			want: map[string]string{
+				"spec.networking.cniManifestURL": "must be set, or cniName and cniVersion",
+				"spec.networking.cilium":         "only applies to the Cilium CNI",
+			},
+		},
+		{
+			name: "Cilium without CLI",
+			modify: func(spec *v1alpha1.ClusterSpec) {
+				spec.Networking.CniName = constants.CniCilium
+				spec.Networking.CniManifestURL = "manifests/cilium.yaml"
+			},
+			want: map[string]string{
+				"spec.networking.cniManifestURL":    `"manifests/cilium.yaml" is neither an http(s) URL nor an absolute path`,
+				"spec.networking.cilium.cliVersion": "must be set, Cilium is installed with the cilium CLI",
+			},
+		},
+		{
+			name: "invalid SANs, storage and runtime",
+			modify: func(spec *v1alpha1.ClusterSpec) {
+				spec.ApiServer = &v1alpha1.ClusterAPIServer{CertSANs: []string{"10.0.0.10", "edge.example.com", "edge_node"}}
+				spec.Storage = &v1alpha1.ClusterStorage{ClusterCsi: &v1alpha1.ContainerStorageInterface{Name: "longhorn"}}
+				spec.ClusterRuntime = &v1alpha1.ClusterRuntime{
+					CustomiseClusterRuntime: true,
+					ClusterCri: &v1alpha1.ContainerRuntimeInterface{
+						RegistryAuth: &v1alpha1.RegistryAuth{IsAuthRequired: true, Username: "robot"},
+					},
+				}
+			},
+			want: map[string]string{
+				"spec.apiServer.certSANs[2]":                           `"edge_node" is neither an IP address nor a DNS name`,
+				"spec.storage.clusterCsi.version":                      "must be set along with the name",
+				"spec.clusterRuntime.clusterCri.privateRegistryFQDN":   "must be set to customise the cluster runtime",
+				"spec.clusterRuntime.clusterCri.registryAuth.password": "must be set when the registry requires authentication",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := kubeadmSpec()
+			SetDefaults(spec)
+			tt.modify(spec)
+			require.Equal(t, tt.want, violations(t, ValidateClusterSpec(spec)))
+		})
+	}
+
+	require.Equal(t, map[string]string{
+		"spec.clusterType": "must be set, one of kubeadm, k3s",
+		"spec.version":     "must be set",
+		"spec.networking":  "must be set, it carries the CNI of the cluster",
+	}, violations(t, ValidateClusterSpec(&v1alpha1.ClusterSpec{})))
+	require.Equal(t, map[string]string{
+		"spec.networking.cniName": "not supported by k3s clusters, which install flannel or the CNI of cniManifestURL",
+	}, violations(t, ValidateClusterSpec(&v1alpha1.ClusterSpec{
+		ClusterType: constants.ClusterTypeK3s,
+		Version:     LatestVersion,
+		Networking:  &v1alpha1.ClusterNetworking{CniName: constants.CniCalico},
+	})))
+}
+
+func TestError_GRPCStatus(t *testing.T) {
+	err := &Error{}
+	require.NoError(t, err.orNil())
+	err.add("spec.version", "must be set")
+	err.add("spec.clusterType", "must be set")
+	require.Equal(t, "invalid request: spec.version: must be set; spec.clusterType: must be set", err.Error())
+
+	st := err.GRPCStatus()
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 2)
+	require.Equal(t, constants.ReasonInvalidSpec, errorutil.ErrorInfo(st).GetReason())
+	require.Equal(t, "false", errorutil.ErrorInfo(st).GetMetadata()["retryable"])
+	badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, badRequest.FieldViolations, 2)
+	require.Equal(t, "spec.version", badRequest.FieldViolations[0].Field)
+	require.Equal(t, "must be set", badRequest.FieldViolations[0].Description)
+}
@@ -0,0 +1,55 @@
+package validation
+
+import "strings"
+
+// immutableFields are the fields of a cluster spec that cannot change once the cluster is created,
+// along with why.
+var immutableFields = []struct {
+	path        string
+	description string
+}{
+	{path: "clusterType", description: "cannot be changed once the cluster is created"},
+	{path: "clusterName", description: "cannot be changed once the cluster is created"},
+	{path: "version", description: "is changed by an upgrade of the cluster"},
+	{path: "networking.podSubnet", description: "cannot be changed once the cluster is created, the pods hold addresses of it"},
+	{path: "networking.svcSubnet", description: "cannot be changed once the cluster is created, the services hold addresses of it"},
+}
+
+// ValidateSpecUpdate checks the fields an update changes in the spec of a created cluster, named by
+// their path from the spec like networking.cniVersion. It returns an *Error listing every changed field
+// that is immutable or, for the other ones, not under one of the updatable paths.
+func ValidateSpecUpdate(changed []string, updatable []string) error {
+	e := &Error{}
+	for _, path := range changed {
+		if description, immutable := immutableField(path); immutable {
+			e.add("spec."+path, description)
+			continue
+		}
+		if !Covers(updatable, path) {
+			e.add("spec."+path, "cannot be changed once the cluster is created")
+		}
+	}
+	return e.orNil()
+}
+
+func immutableField(path string) (string, bool) {
+	for _, field := range immutableFields {
+		if Covers([]string{path}, field.path) {
+			return field.description, true
+		}
+	}
+	return "", false
+}
+
+// Covers tells whether one of the paths covers one of the fields: it is the path of the field, of a
+// field the field holds or of the message holding the field.
+func Covers(paths []string, fields ...string) bool {
+	for _, p := range paths {
+		for _, field := range fields {
+			if p == field || strings.HasPrefix(field, p+".") || strings.HasPrefix(p, field+".") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSpecUpdate(t *testing.T) {
+	updatable := []string{"networking.cniVersion", "storage", "disableWorkloads"}
+	require.NoError(t, ValidateSpecUpdate([]string{"networking.cniVersion", "storage.clusterCsi.version"}, updatable))
+	require.Equal(t, map[string]string{
+		"spec.version":              "is changed by an upgrade of the cluster",
+		"spec.networking.podSubnet": "cannot be changed once the cluster is created, the pods hold addresses of it",
+		"spec.apiServer.certSANs":   "cannot be changed once the cluster is created",
+	}, violations(t, ValidateSpecUpdate([]string{"version", "networking.podSubnet", "networking.cniVersion", "apiServer.certSANs"}, updatable)))
+
+	require.True(t, Covers([]string{"networking"}, "networking.cniName"))
+	require.True(t, Covers([]string{"storage.clusterCsi.name"}, "storage"))
+	require.False(t, Covers([]string{"networking.cniNameX"}, "networking.cniName"))
+}
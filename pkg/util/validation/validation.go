@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"fmt"
+	"kubeclusteragent/pkg/constants"
+	errorutil "kubeclusteragent/pkg/util/error"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error is the error of a request with invalid fields, it is returned to the clients as an
+// InvalidArgument status with a BadRequest detail listing the field violations.
+type Error struct {
+	Violations []*errdetails.BadRequest_FieldViolation
+}
+
+// NewError returns the error of a single invalid field.
+func NewError(field, description string) *Error {
+	e := &Error{}
+	e.add(field, description)
+	return e
+}
+
+func (e *Error) add(field, description string) {
+	e.Violations = append(e.Violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+}
+
+func (e *Error) Error() string {
+	violations := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		violations = append(violations, fmt.Sprintf("%s: %s", violation.Field, violation.Description))
+	}
+	return "invalid request: " + strings.Join(violations, "; ")
+}
+
+// GRPCStatus returns the InvalidArgument status of the error, with the violations as BadRequest details.
+func (e *Error) GRPCStatus() *status.Status {
+	st := errorutil.New(codes.InvalidArgument, constants.ReasonInvalidSpec, e.Error()).GRPCStatus()
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: e.Violations})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// orNil returns the error when it holds violations, nil otherwise.
+func (e *Error) orNil() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}